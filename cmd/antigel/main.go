@@ -0,0 +1,272 @@
+// Command antigel is the single entrypoint for the SideQuests backend
+// server and its companion CLI tools (NFT viewer, contract/health
+// inspection, config resolution).
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/Al3x-Myku/Antigel/internal/backend"
+	"github.com/Al3x-Myku/Antigel/internal/config"
+	"github.com/Al3x-Myku/Antigel/internal/nftviewer"
+)
+
+// rpcURLFlag, deploymentFlag, networkFlag, and verbosityFlag are global:
+// every subcommand resolves its config.Config from them via resolveConfig.
+var (
+	rpcURLFlag = &cli.StringFlag{
+		Name:    "rpc-url",
+		Usage:   "Ethereum JSON-RPC endpoint (env ANTIGEL_RPC_URL)",
+		EnvVars: []string{"ANTIGEL_RPC_URL"},
+	}
+	deploymentFlag = &cli.StringFlag{
+		Name:    "deployment",
+		Usage:   "path to deployment.json (env ANTIGEL_DEPLOYMENT)",
+		EnvVars: []string{"ANTIGEL_DEPLOYMENT"},
+	}
+	networkFlag = &cli.StringFlag{
+		Name:    "network",
+		Usage:   "mainnet, sepolia, or local (env ANTIGEL_NETWORK)",
+		EnvVars: []string{"ANTIGEL_NETWORK"},
+	}
+	verbosityFlag = &cli.IntFlag{
+		Name:  "verbosity",
+		Usage: "verbosity level, shown by `antigel config`; log output is not filtered by it",
+		Value: 1,
+	}
+)
+
+// resolveConfig builds a config.Config from the global flags, the same way
+// for every subcommand.
+func resolveConfig(c *cli.Context) (config.Config, error) {
+	return config.Resolve(
+		c.String("rpc-url"),
+		c.String("deployment"),
+		c.String("network"),
+		c.Int("verbosity"),
+	)
+}
+
+func main() {
+	app := &cli.App{
+		Name:  "antigel",
+		Usage: "SideQuests backend server and CLI tools",
+		Flags: []cli.Flag{rpcURLFlag, deploymentFlag, networkFlag, verbosityFlag},
+		Commands: []*cli.Command{
+			serveCommand,
+			healthCommand,
+			configCommand,
+			contractsCommand,
+			nftCommand,
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
+
+var serveCommand = &cli.Command{
+	Name:  "serve",
+	Usage: "run the SideQuests HTTP/JSON-RPC server",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "addr",
+			Usage: "address to listen on",
+			Value: ":8080",
+		},
+		&cli.StringFlag{
+			Name:    "jwt-secret",
+			Usage:   "HMAC secret for signing SIWE session cookies (env ANTIGEL_JWT_SECRET)",
+			EnvVars: []string{"ANTIGEL_JWT_SECRET"},
+		},
+	},
+	Action: func(c *cli.Context) error {
+		cfg, err := resolveConfig(c)
+		if err != nil {
+			return err
+		}
+		return backend.Serve(cfg, c.String("addr"), c.String("jwt-secret"))
+	},
+}
+
+var healthCommand = &cli.Command{
+	Name:  "health",
+	Usage: "check connectivity to the configured Ethereum network",
+	Action: func(c *cli.Context) error {
+		cfg, err := resolveConfig(c)
+		if err != nil {
+			return err
+		}
+		result, err := backend.CheckHealth(cfg)
+		if err != nil {
+			return err
+		}
+		return printJSON(result)
+	},
+}
+
+var configCommand = &cli.Command{
+	Name:  "config",
+	Usage: "print the resolved configuration",
+	Action: func(c *cli.Context) error {
+		cfg, err := resolveConfig(c)
+		if err != nil {
+			return err
+		}
+		return printJSON(cfg)
+	},
+}
+
+var contractsCommand = &cli.Command{
+	Name:  "contracts",
+	Usage: "inspect deployed contracts",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "addresses",
+			Usage: "print deployed contract addresses",
+			Action: func(c *cli.Context) error {
+				cfg, err := resolveConfig(c)
+				if err != nil {
+					return err
+				}
+				result, err := backend.ContractAddresses(cfg)
+				if err != nil {
+					return err
+				}
+				return printJSON(result)
+			},
+		},
+	},
+}
+
+var nftCommand = &cli.Command{
+	Name:  "nft",
+	Usage: "inspect Achievement NFT badges",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "view",
+			Usage:     "print a single badge's metadata",
+			ArgsUsage: "<tokenID>",
+			Action: func(c *cli.Context) error {
+				tokenID, err := strconv.ParseInt(c.Args().First(), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid token ID %q: %v", c.Args().First(), err)
+				}
+
+				cfg, err := resolveConfig(c)
+				if err != nil {
+					return err
+				}
+				viewer, err := nftviewer.Connect(cfg)
+				if err != nil {
+					return err
+				}
+				defer viewer.Close()
+
+				metadata, err := viewer.Metadata(tokenID)
+				if err != nil {
+					return err
+				}
+
+				nftviewer.PrintBadge(tokenID, metadata)
+				return nil
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "print a summary of every minted badge",
+			Action: func(c *cli.Context) error {
+				cfg, err := resolveConfig(c)
+				if err != nil {
+					return err
+				}
+				viewer, err := nftviewer.Connect(cfg)
+				if err != nil {
+					return err
+				}
+				defer viewer.Close()
+
+				summaries, err := viewer.List()
+				if err != nil {
+					return err
+				}
+				return printJSON(summaries)
+			},
+		},
+		{
+			Name:  "export",
+			Usage: "export every minted badge as json or csv",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "format",
+					Usage: "json or csv",
+					Value: "json",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				cfg, err := resolveConfig(c)
+				if err != nil {
+					return err
+				}
+				viewer, err := nftviewer.Connect(cfg)
+				if err != nil {
+					return err
+				}
+				defer viewer.Close()
+
+				summaries, err := viewer.List()
+				if err != nil {
+					return err
+				}
+
+				switch c.String("format") {
+				case "json":
+					return printJSON(summaries)
+				case "csv":
+					return writeCSV(summaries)
+				default:
+					return fmt.Errorf("unsupported format %q (want json or csv)", c.String("format"))
+				}
+			},
+		},
+	},
+}
+
+func printJSON(v interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func writeCSV(summaries []nftviewer.Summary) error {
+	w := csv.NewWriter(os.Stdout)
+	defer w.Flush()
+
+	if err := w.Write([]string{"tokenId", "title", "description", "achievementType", "rarity", "image"}); err != nil {
+		return err
+	}
+
+	for _, s := range summaries {
+		row := []string{
+			strconv.FormatInt(s.TokenID, 10),
+			s.Title,
+			s.Description,
+			s.AchievementType,
+			s.Rarity,
+			s.Image,
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
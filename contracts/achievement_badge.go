@@ -0,0 +1,105 @@
+package contracts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BadgeData mirrors the Solidity BadgeData struct stored in the badges
+// mapping, field-for-field.
+type BadgeData struct {
+	AchievementType uint8
+	Title           string
+	Description     string
+	ImageURI        string
+	MintedAt        *big.Int
+	Rarity          *big.Int
+}
+
+// AchievementBadgeContract is a typed binding for the AchievementBadge
+// ERC-721 contract.
+type AchievementBadgeContract struct {
+	contract *bind.BoundContract
+}
+
+// NewAchievementBadgeContract creates a typed binding for the
+// AchievementBadge contract deployed at address, using abiJSON as its
+// parsed ABI and backend for calls/sends.
+func NewAchievementBadgeContract(address common.Address, abiJSON abi.ABI, backend bind.ContractBackend) *AchievementBadgeContract {
+	return &AchievementBadgeContract{contract: bind.NewBoundContract(address, abiJSON, backend, backend, backend)}
+}
+
+// Badges returns the BadgeData stored for tokenID. bind.BoundContract.Call
+// decodes into a []interface{} with one element per contract output, so
+// each field is type-asserted back out of the slice rather than into a
+// single typed pointer.
+func (a *AchievementBadgeContract) Badges(opts *bind.CallOpts, tokenID *big.Int) (BadgeData, error) {
+	out := make([]interface{}, 6)
+	if err := a.contract.Call(opts, &out, "badges", tokenID); err != nil {
+		return BadgeData{}, err
+	}
+
+	achievementType, ok := out[0].(uint8)
+	if !ok {
+		return BadgeData{}, fmt.Errorf("unexpected badges field 0 (achievementType) type %T", out[0])
+	}
+	title, ok := out[1].(string)
+	if !ok {
+		return BadgeData{}, fmt.Errorf("unexpected badges field 1 (title) type %T", out[1])
+	}
+	description, ok := out[2].(string)
+	if !ok {
+		return BadgeData{}, fmt.Errorf("unexpected badges field 2 (description) type %T", out[2])
+	}
+	imageURI, ok := out[3].(string)
+	if !ok {
+		return BadgeData{}, fmt.Errorf("unexpected badges field 3 (imageURI) type %T", out[3])
+	}
+	mintedAt, ok := out[4].(*big.Int)
+	if !ok {
+		return BadgeData{}, fmt.Errorf("unexpected badges field 4 (mintedAt) type %T", out[4])
+	}
+	rarity, ok := out[5].(*big.Int)
+	if !ok {
+		return BadgeData{}, fmt.Errorf("unexpected badges field 5 (rarity) type %T", out[5])
+	}
+
+	return BadgeData{
+		AchievementType: achievementType,
+		Title:           title,
+		Description:     description,
+		ImageURI:        imageURI,
+		MintedAt:        mintedAt,
+		Rarity:          rarity,
+	}, nil
+}
+
+// TotalSupply returns the number of badges minted so far.
+func (a *AchievementBadgeContract) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	out := make([]interface{}, 1)
+	if err := a.contract.Call(opts, &out, "totalSupply"); err != nil {
+		return nil, err
+	}
+	supply, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected totalSupply return type %T", out[0])
+	}
+	return supply, nil
+}
+
+// TokenURI returns the ERC-721 metadata URI for tokenID.
+func (a *AchievementBadgeContract) TokenURI(opts *bind.CallOpts, tokenID *big.Int) (string, error) {
+	out := make([]interface{}, 1)
+	if err := a.contract.Call(opts, &out, "tokenURI", tokenID); err != nil {
+		return "", err
+	}
+	uri, ok := out[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected tokenURI return type %T", out[0])
+	}
+	return uri, nil
+}
@@ -0,0 +1,47 @@
+package contracts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RewardContract is a typed binding for the HLP reward token contract.
+type RewardContract struct {
+	contract *bind.BoundContract
+}
+
+// NewRewardContract creates a typed binding for the Reward contract deployed
+// at address, using abiJSON as its parsed ABI and backend for calls/sends.
+func NewRewardContract(address common.Address, abiJSON abi.ABI, backend bind.ContractBackend) *RewardContract {
+	return &RewardContract{contract: bind.NewBoundContract(address, abiJSON, backend, backend, backend)}
+}
+
+// BalanceOf returns the token balance held by owner.
+func (r *RewardContract) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	out := make([]interface{}, 1)
+	if err := r.contract.Call(opts, &out, "balanceOf", owner); err != nil {
+		return nil, err
+	}
+	balance, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected balanceOf return type %T", out[0])
+	}
+	return balance, nil
+}
+
+// TotalSupply returns the total amount of reward tokens minted.
+func (r *RewardContract) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	out := make([]interface{}, 1)
+	if err := r.contract.Call(opts, &out, "totalSupply"); err != nil {
+		return nil, err
+	}
+	supply, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected totalSupply return type %T", out[0])
+	}
+	return supply, nil
+}
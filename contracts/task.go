@@ -0,0 +1,99 @@
+// Package contracts holds typed Go wrappers for the SideQuests smart
+// contracts. Each contract's ABI comes from deployment.json at runtime
+// (the same address/ABI can differ per network and per deploy), so it
+// isn't known at build time - which is what abigen needs, and why these
+// wrappers are hand-maintained bind.BoundContract.Call callers rather than
+// abigen output. A field reordered in Solidity won't fail to compile here.
+// The type assertions below return a decode error when the reorder moves a
+// field onto a slot of a different Go type; fields of the same type (two
+// strings, say) swap silently.
+package contracts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Task mirrors the Solidity Task struct field-for-field.
+type Task struct {
+	Title       string
+	Description string
+	Reward      *big.Int
+	Creator     common.Address
+	Worker      common.Address
+	IsCompleted bool
+}
+
+// TaskContract is a typed binding for the Task contract.
+type TaskContract struct {
+	contract *bind.BoundContract
+}
+
+// NewTaskContract creates a typed binding for the Task contract deployed at
+// address, using abiJSON as its parsed ABI and backend for calls/sends.
+func NewTaskContract(address common.Address, abiJSON abi.ABI, backend bind.ContractBackend) *TaskContract {
+	return &TaskContract{contract: bind.NewBoundContract(address, abiJSON, backend, backend, backend)}
+}
+
+// GetTaskCount returns the total number of tasks created.
+func (t *TaskContract) GetTaskCount(opts *bind.CallOpts) (*big.Int, error) {
+	out := make([]interface{}, 1)
+	if err := t.contract.Call(opts, &out, "getTaskCount"); err != nil {
+		return nil, err
+	}
+	taskCount, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected getTaskCount return type %T", out[0])
+	}
+	return taskCount, nil
+}
+
+// Tasks returns the Task stored at index. bind.BoundContract.Call decodes
+// into a []interface{} with one element per contract output, same as the
+// positional decoding getTasksHandler used before this package existed, so
+// each field is type-asserted back out of the slice rather than into a
+// single typed pointer.
+func (t *TaskContract) Tasks(opts *bind.CallOpts, index *big.Int) (Task, error) {
+	out := make([]interface{}, 6)
+	if err := t.contract.Call(opts, &out, "tasks", index); err != nil {
+		return Task{}, err
+	}
+
+	title, ok := out[0].(string)
+	if !ok {
+		return Task{}, fmt.Errorf("unexpected tasks field 0 (title) type %T", out[0])
+	}
+	description, ok := out[1].(string)
+	if !ok {
+		return Task{}, fmt.Errorf("unexpected tasks field 1 (description) type %T", out[1])
+	}
+	reward, ok := out[2].(*big.Int)
+	if !ok {
+		return Task{}, fmt.Errorf("unexpected tasks field 2 (reward) type %T", out[2])
+	}
+	creator, ok := out[3].(common.Address)
+	if !ok {
+		return Task{}, fmt.Errorf("unexpected tasks field 3 (creator) type %T", out[3])
+	}
+	worker, ok := out[4].(common.Address)
+	if !ok {
+		return Task{}, fmt.Errorf("unexpected tasks field 4 (worker) type %T", out[4])
+	}
+	isCompleted, ok := out[5].(bool)
+	if !ok {
+		return Task{}, fmt.Errorf("unexpected tasks field 5 (isCompleted) type %T", out[5])
+	}
+
+	return Task{
+		Title:       title,
+		Description: description,
+		Reward:      reward,
+		Creator:     creator,
+		Worker:      worker,
+		IsCompleted: isCompleted,
+	}, nil
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -9,43 +8,30 @@ import (
 	"log"
 	"net/http"
 	"os"
-	"os/exec"
+	"strconv"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/firestore"
 	firebase "firebase.google.com/go"
+	"go.uber.org/zap"
 	"google.golang.org/api/option"
+
+	"github.com/Al3x-Myku/Antigel/internal/artifacts"
+	"github.com/Al3x-Myku/Antigel/internal/autotrigger"
+	"github.com/Al3x-Myku/Antigel/internal/jobqueue"
+	"github.com/Al3x-Myku/Antigel/internal/selector"
+	"github.com/Al3x-Myku/Antigel/internal/telemetry"
 )
 
 // TaskRequest represents the incoming task JSON
-type TaskRequest struct {
-	ID           string   `json:"id"`
-	Title        string   `json:"title"`
-	Description  string   `json:"description"`
-	Requirements []string `json:"requirements"`
-	Skills       []string `json:"skills"`
-	Reward       float64  `json:"reward"`
-	Deadline     string   `json:"deadline"`
-	Creator      string   `json:"creator"`
-}
+type TaskRequest = selector.TaskRequest
 
 // GraphRAGResponse represents the response from GraphRAG query
-type GraphRAGResponse struct {
-	SelectedMembers []SelectedMember `json:"selected_members"`
-	TaskID          string           `json:"task_id"`
-	Confidence      float64          `json:"confidence"`
-	Reasoning       string           `json:"reasoning"`
-}
+type GraphRAGResponse = selector.GraphRAGResponse
 
 // SelectedMember represents a member selected by GraphRAG
-type SelectedMember struct {
-	UserID    string   `json:"user_id"`
-	Name      string   `json:"name"`
-	Skills    []string `json:"skills"`
-	Score     float64  `json:"score"`
-	Rationale string   `json:"rationale"`
-}
+type SelectedMember = selector.SelectedMember
 
 // FirebaseTaskUpdate represents the data to update in Firebase
 type FirebaseTaskUpdate struct {
@@ -54,7 +40,12 @@ type FirebaseTaskUpdate struct {
 	Status          string           `json:"status"`
 }
 
-var firestoreClient *firestore.Client
+var (
+	firestoreClient *firestore.Client
+	memberSelector  selector.Selector
+	jobQueueClient  *jobqueue.Client
+	artifactStore   artifacts.Store
+)
 
 // initializeFirebase initializes the Firebase client
 func initializeFirebase() error {
@@ -83,105 +74,18 @@ func initializeFirebase() error {
 	return nil
 }
 
-// runGraphRAGQuery executes the GraphRAG query command with task data
-func runGraphRAGQuery(task TaskRequest) (*GraphRAGResponse, error) {
-	log.Printf("🔍 Running GraphRAG query for task: %s", task.ID)
-
-	// Convert task to JSON for GraphRAG input
-	taskJSON, err := json.Marshal(task)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling task to JSON: %v", err)
-	}
-
-	// Prepare GraphRAG command with task data
-	query := fmt.Sprintf("Find the best team members for this task: %s", string(taskJSON))
-
-	// Run GraphRAG command (adjust command based on your GraphRAG setup)
-	cmd := exec.Command("graphrag", "query",
-		"--root", "../python/graphrag",
-		"--method", "local",
-		"--query", query)
+// updateFirebaseWithMembers updates Firebase with the selected members and
+// the URLs of any artifacts SaveTask saved for this run.
+func updateFirebaseWithMembers(ctx context.Context, taskID string, response *GraphRAGResponse, artifactURLs map[string]string) error {
+	start := time.Now()
+	defer func() { telemetry.FirestoreUpdateDuration.Observe(time.Since(start).Seconds()) }()
 
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	err = cmd.Run()
-	if err != nil {
-		return nil, fmt.Errorf("GraphRAG command failed: %v, stderr: %s", err, stderr.String())
-	}
-
-	// Parse GraphRAG response
-	output := stdout.String()
-	log.Printf("📊 GraphRAG raw output: %s", output)
-
-	// Extract JSON from GraphRAG output (you may need to adjust this parsing)
-	response, err := parseGraphRAGOutput(output, task.ID)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing GraphRAG output: %v", err)
-	}
-
-	log.Printf("✅ GraphRAG found %d selected members", len(response.SelectedMembers))
-	return response, nil
-}
-
-// parseGraphRAGOutput parses the GraphRAG output and extracts member selection
-func parseGraphRAGOutput(output, taskID string) (*GraphRAGResponse, error) {
-	// This is a simplified parser - you may need to adjust based on actual GraphRAG output format
-	response := &GraphRAGResponse{
-		TaskID:          taskID,
-		SelectedMembers: []SelectedMember{},
-		Confidence:      0.8, // Default confidence
-		Reasoning:       "GraphRAG analysis completed",
-	}
-
-	// Try to find JSON in the output
-	lines := strings.Split(output, "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if strings.HasPrefix(line, "{") && strings.HasSuffix(line, "}") {
-			var jsonResponse map[string]interface{}
-			if err := json.Unmarshal([]byte(line), &jsonResponse); err == nil {
-				// Parse the JSON response and extract member data
-				if members, ok := jsonResponse["members"].([]interface{}); ok {
-					for _, member := range members {
-						if memberMap, ok := member.(map[string]interface{}); ok {
-							selectedMember := SelectedMember{
-								UserID:    fmt.Sprintf("%v", memberMap["user_id"]),
-								Name:      fmt.Sprintf("%v", memberMap["name"]),
-								Score:     0.8, // Default score
-								Rationale: "Selected by GraphRAG analysis",
-							}
-
-							if skills, ok := memberMap["skills"].([]interface{}); ok {
-								for _, skill := range skills {
-									selectedMember.Skills = append(selectedMember.Skills, fmt.Sprintf("%v", skill))
-								}
-							}
-
-							response.SelectedMembers = append(response.SelectedMembers, selectedMember)
-						}
-					}
-				}
-			}
-		}
-	}
-
-	// If no structured data found, create mock response based on output analysis
-	if len(response.SelectedMembers) == 0 {
-		log.Println("⚠️ No structured member data found, creating analysis-based response")
-		// You can implement text analysis here to extract member information
-		// For now, return empty response
-	}
-
-	return response, nil
-}
-
-// updateFirebaseWithMembers updates Firebase with the selected members
-func updateFirebaseWithMembers(taskID string, response *GraphRAGResponse) error {
-	log.Printf("📝 Updating Firebase for task %s with %d members", taskID, len(response.SelectedMembers))
-
-	ctx := context.Background()
+	traceID := telemetry.TraceID(ctx)
+	telemetry.Log.Info("updating firestore",
+		zap.String("trace_id", traceID),
+		zap.String("task_id", taskID),
+		zap.Int("selected_members", len(response.SelectedMembers)),
+	)
 
 	updateData := FirebaseTaskUpdate{
 		SelectedMembers: response.SelectedMembers,
@@ -196,95 +100,270 @@ func updateFirebaseWithMembers(taskID string, response *GraphRAGResponse) error
 		{Path: "status", Value: updateData.Status},
 		{Path: "graphrag_reasoning", Value: response.Reasoning},
 		{Path: "confidence_score", Value: response.Confidence},
+		{Path: "artifacts", Value: artifactURLs},
+		{Path: "trace_id", Value: traceID},
 	})
 
 	if err != nil {
+		telemetry.Log.Error("firestore update failed", zap.String("trace_id", traceID), zap.String("task_id", taskID), zap.Error(err))
 		return fmt.Errorf("error updating Firestore: %v", err)
 	}
 
-	log.Printf("✅ Successfully updated Firebase for task %s", taskID)
 	return nil
 }
 
-// processTaskHandler handles POST requests with task JSON
+// processTaskHandler handles POST requests with task JSON. GraphRAG
+// selection runs in the background (see jobqueue); this only enqueues the
+// job and returns its IDs so long queries can't tie up the request.
 func processTaskHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Only POST method allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
-	log.Println("📥 Received task processing request")
+	traceID := telemetry.TraceID(r.Context())
+	log := telemetry.Log.With(zap.String("trace_id", traceID))
+	log.Info("received task processing request")
 
 	// Read request body
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
-		log.Printf("❌ Error reading request body: %v", err)
+		log.Error("error reading request body", zap.Error(err))
 		http.Error(w, "Error reading request body", http.StatusBadRequest)
 		return
 	}
 	defer r.Body.Close()
 
-	// Parse task JSON
-	var task TaskRequest
-	if err := json.Unmarshal(body, &task); err != nil {
-		log.Printf("❌ Error parsing JSON: %v", err)
+	// Parse task JSON. dry_run is our own extension on top of TaskRequest,
+	// not part of the GraphRAG wire contract, so it's kept local to this
+	// handler instead of living on selector.TaskRequest.
+	var req struct {
+		TaskRequest
+		DryRun bool `json:"dry_run"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		log.Error("error parsing task JSON", zap.Error(err))
 		http.Error(w, "Invalid JSON format", http.StatusBadRequest)
 		return
 	}
+	task := req.TaskRequest
+	log = log.With(zap.String("task_id", task.ID))
+
+	if req.DryRun {
+		log.Info("dry-run: running GraphRAG without touching Firebase")
+		start := time.Now()
+		result, err := memberSelector.SelectMembers(r.Context(), task)
+		telemetry.GraphRAGQueryDuration.Observe(time.Since(start).Seconds())
+		if err != nil {
+			log.Error("dry-run GraphRAG query failed", zap.Error(err))
+			http.Error(w, fmt.Sprintf("GraphRAG processing failed: %v", err), http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			log.Error("error encoding response", zap.Error(err))
+			http.Error(w, "Error encoding response", http.StatusInternalServerError)
+		}
+		return
+	}
 
-	log.Printf("📋 Processing task: %s - %s", task.ID, task.Title)
+	log.Info("queuing task", zap.String("title", task.Title))
 
-	// Run GraphRAG query
-	graphRAGResponse, err := runGraphRAGQuery(task)
+	jobID, err := jobQueueClient.Enqueue(r.Context(), task)
 	if err != nil {
-		log.Printf("❌ GraphRAG query failed: %v", err)
-		http.Error(w, fmt.Sprintf("GraphRAG query failed: %v", err), http.StatusInternalServerError)
+		log.Error("failed to enqueue task", zap.Error(err))
+		http.Error(w, fmt.Sprintf("Failed to enqueue task: %v", err), http.StatusInternalServerError)
 		return
 	}
 
-	// Update Firebase with selected members
-	if err := updateFirebaseWithMembers(task.ID, graphRAGResponse); err != nil {
-		log.Printf("❌ Firebase update failed: %v", err)
-		http.Error(w, fmt.Sprintf("Firebase update failed: %v", err), http.StatusInternalServerError)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"task_id":  task.ID,
+		"job_id":   jobID,
+		"status":   jobqueue.StatusQueued,
+		"trace_id": traceID,
+	}); err != nil {
+		log.Error("error encoding response", zap.Error(err))
+		http.Error(w, "Error encoding response", http.StatusInternalServerError)
 		return
 	}
 
-	// Return success response
-	w.Header().Set("Content-Type", "application/json")
-	response := map[string]interface{}{
-		"success":                true,
-		"task_id":                task.ID,
-		"selected_members_count": len(graphRAGResponse.SelectedMembers),
-		"confidence":             graphRAGResponse.Confidence,
-		"reasoning":              graphRAGResponse.Reasoning,
+	log.Info("task queued", zap.String("job_id", jobID))
+}
+
+// taskStatusHandler serves GET /tasks/{id}/status and GET /tasks/{id}/result.
+func taskStatusHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Only GET method allowed", http.StatusMethodNotAllowed)
+		return
 	}
 
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		log.Printf("❌ Error encoding response: %v", err)
-		http.Error(w, "Error encoding response", http.StatusInternalServerError)
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/tasks/"), "/")
+	if len(parts) != 2 || parts[0] == "" {
+		http.Error(w, "expected /tasks/{id}/status or /tasks/{id}/result", http.StatusNotFound)
+		return
+	}
+	taskID, view := parts[0], parts[1]
+
+	state, ok := jobQueueClient.Get(taskID)
+	if !ok {
+		http.Error(w, "no job found for this task", http.StatusNotFound)
 		return
 	}
 
-	log.Printf("✅ Task %s processed successfully", task.ID)
+	w.Header().Set("Content-Type", "application/json")
+
+	switch view {
+	case "status":
+		json.NewEncoder(w).Encode(state)
+	case "result":
+		switch state.Status {
+		case jobqueue.StatusCompleted:
+			json.NewEncoder(w).Encode(state.Result)
+		case jobqueue.StatusFailed:
+			// A failed job means an upstream step failed: the Selector
+			// (GraphRAG output it couldn't parse or validate, or a failed
+			// query) or the Firestore write of its result. Either way it's
+			// surfaced as a bad gateway rather than a generic conflict.
+			http.Error(w, fmt.Sprintf("GraphRAG processing failed: %s", state.Error), http.StatusBadGateway)
+		default:
+			http.Error(w, fmt.Sprintf("task is %s, not completed", state.Status), http.StatusConflict)
+		}
+	default:
+		http.Error(w, fmt.Sprintf("unknown task view %q", view), http.StatusNotFound)
+	}
+}
+
+// instrument wraps next with request tracing (a trace ID, generated or
+// forwarded from the X-Trace-Id header, is attached to the request
+// context for the rest of the pipeline to log and persist) and an
+// http_request_duration_seconds observation labeled by route and status.
+func instrument(route string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		traceID := r.Header.Get("X-Trace-Id")
+		if traceID == "" {
+			generated, err := telemetry.NewTraceID()
+			if err != nil {
+				telemetry.Log.Error("failed to generate trace id", zap.Error(err))
+			}
+			traceID = generated
+		}
+		r = r.WithContext(telemetry.WithTraceID(r.Context(), traceID))
+		w.Header().Set("X-Trace-Id", traceID)
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		next(recorder, r)
+		telemetry.HTTPRequestDuration.WithLabelValues(route, strconv.Itoa(recorder.status)).Observe(time.Since(start).Seconds())
+	}
+}
+
+// statusRecorder captures the status code an http.Handler wrote, since
+// net/http doesn't expose it after the fact.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// autotriggerInstanceID identifies this process for the task listener's
+// leader election; it only needs to be unique per running instance.
+func autotriggerInstanceID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown-host"
+	}
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
 }
 
 func main() {
 	log.Println("🚀 Starting GraphRAG Task Processing Endpoint...")
 
+	if err := telemetry.Init(); err != nil {
+		log.Fatalf("❌ Failed to initialize structured logger: %v", err)
+	}
+	defer telemetry.Log.Sync()
+
 	// Initialize Firebase
 	if err := initializeFirebase(); err != nil {
 		log.Fatalf("❌ Failed to initialize Firebase: %v", err)
 	}
 	defer firestoreClient.Close()
 
+	// Build the GraphRAG Selector chosen via GRAPHRAG_SELECTOR (subprocess,
+	// http, or grpc), so swapping engines doesn't touch handler code.
+	var err error
+	memberSelector, err = selector.NewFromEnv()
+	if err != nil {
+		log.Fatalf("❌ Failed to initialize GraphRAG selector: %v", err)
+	}
+
+	// Set up the Redis-backed job queue: process-task requests enqueue a
+	// job here instead of running GraphRAG inline, and a worker pool
+	// drains it in the background.
+	redisAddr := os.Getenv("REDIS_ADDR")
+	if redisAddr == "" {
+		redisAddr = "localhost:6379"
+	}
+	jobQueueClient = jobqueue.NewClient(redisAddr)
+	defer jobQueueClient.Close()
+
+	concurrency := 10
+	if v := os.Getenv("WORKER_CONCURRENCY"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			concurrency = n
+		}
+	}
+
+	artifactStore, err = artifacts.NewFromEnv(context.Background())
+	if err != nil {
+		log.Fatalf("❌ Failed to initialize artifact storage: %v", err)
+	}
+
+	handler := &jobqueue.Handler{
+		Selector: memberSelector,
+		OnResult: func(ctx context.Context, task TaskRequest, result *GraphRAGResponse, raw []byte) error {
+			artifactURLs, err := artifacts.SaveTask(ctx, artifactStore, task.ID, task, raw, result)
+			if err != nil {
+				telemetry.Log.Warn("failed to save artifacts",
+					zap.String("trace_id", telemetry.TraceID(ctx)),
+					zap.String("task_id", task.ID),
+					zap.Error(err))
+				artifactURLs = map[string]string{}
+			}
+			return updateFirebaseWithMembers(ctx, task.ID, result, artifactURLs)
+		},
+	}
+
+	worker := jobqueue.NewServer(redisAddr, concurrency)
+	go func() {
+		if err := worker.Run(jobqueue.NewMux(handler)); err != nil {
+			log.Fatalf("❌ Worker server failed: %v", err)
+		}
+	}()
+
+	// Watch Firestore for task documents clients write directly with
+	// status "pending", so they don't all need to call /process-task.
+	// Leader election over the system/_leader doc keeps only one running
+	// instance of this service claiming tasks at a time.
+	instanceID := autotriggerInstanceID()
+	listener := autotrigger.NewListener(firestoreClient, jobQueueClient.Enqueue, instanceID)
+	go listener.Run(context.Background())
+
 	// Setup HTTP routes
-	http.HandleFunc("/process-task", processTaskHandler)
+	http.HandleFunc("/process-task", instrument("process-task", processTaskHandler))
+	http.HandleFunc("/tasks/", instrument("tasks", taskStatusHandler))
+	http.Handle("/metrics", telemetry.Handler())
 
 	// Health check endpoint
-	http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+	http.HandleFunc("/health", instrument("health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		fmt.Fprintf(w, "OK")
-	})
+	}))
 
 	// Start server
 	port := os.Getenv("PORT")
@@ -294,8 +373,13 @@ func main() {
 
 	log.Printf("🌐 Server listening on port %s", port)
 	log.Printf("📡 Endpoints available:")
-	log.Printf("  POST /process-task - Process task with GraphRAG")
-	log.Printf("  GET  /health       - Health check")
+	log.Printf("  POST /process-task      - Queue a task for GraphRAG processing (dry_run: true to test without persisting)")
+	log.Printf("  GET  /tasks/{id}/status - Check a queued task's progress")
+	log.Printf("  GET  /tasks/{id}/result - Fetch a completed task's result")
+	log.Printf("  GET  /health            - Health check")
+	log.Printf("  GET  /metrics           - Prometheus metrics")
+	log.Printf("👷 Worker pool running with concurrency %d against redis %s", concurrency, redisAddr)
+	log.Printf("👂 Watching Firestore for pending tasks as instance %s", instanceID)
 
 	if err := http.ListenAndServe(":"+port, nil); err != nil {
 		log.Fatalf("❌ Server failed to start: %v", err)
@@ -0,0 +1,87 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// knownEventNames lists the Solidity events the Indexer watches; each name
+// is looked up in the Task ABI first, then the AchievementBadge ABI, and is
+// also the Event.Type used in the persisted record.
+var knownEventNames = []string{"TaskCreated", "TaskCompleted", "BadgeMinted"}
+
+// Topics builds the [][]common.Hash filter the Indexer matches against: a
+// single topic-0 row listing every known event's ID, so logs of any of
+// these types are picked up.
+func Topics(taskABI, badgeABI abi.ABI) ([][]common.Hash, error) {
+	var topics []common.Hash
+
+	for _, name := range knownEventNames {
+		event, ok := taskABI.Events[name]
+		if !ok {
+			event, ok = badgeABI.Events[name]
+		}
+		if !ok {
+			continue
+		}
+		topics = append(topics, event.ID)
+	}
+
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("none of %v found in the provided ABIs", knownEventNames)
+	}
+
+	return [][]common.Hash{topics}, nil
+}
+
+// Decode returns a decode function that turns a raw log from either the
+// Task or AchievementBadge contract into a persisted Event, keyed by event
+// name and keyword arguments.
+func Decode(taskABI, badgeABI abi.ABI) func(types.Log) (*Event, error) {
+	return func(l types.Log) (*Event, error) {
+		if len(l.Topics) == 0 {
+			return nil, nil
+		}
+
+		for _, contractABI := range []abi.ABI{taskABI, badgeABI} {
+			event, err := contractABI.EventByID(l.Topics[0])
+			if err != nil {
+				continue
+			}
+
+			data := make(map[string]interface{})
+			if err := contractABI.UnpackIntoMap(data, event.Name, l.Data); err != nil {
+				return nil, fmt.Errorf("failed to unpack %s: %v", event.Name, err)
+			}
+
+			// Indexed arguments live in Topics[1:], not Data. abi.Arguments
+			// has no Indexed() filter, so walk it and keep a separate
+			// counter for the indexed ones.
+			indexedCount := 0
+			for _, arg := range event.Inputs {
+				if !arg.Indexed {
+					continue
+				}
+				if indexedCount+1 >= len(l.Topics) {
+					break
+				}
+				data[arg.Name] = l.Topics[indexedCount+1].Hex()
+				indexedCount++
+			}
+
+			return &Event{
+				Type:        event.Name,
+				BlockNumber: l.BlockNumber,
+				TxHash:      l.TxHash.Hex(),
+				LogIndex:    l.Index,
+				Data:        data,
+			}, nil
+		}
+
+		// Not one of the events we care about.
+		return nil, nil
+	}
+}
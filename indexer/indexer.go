@@ -0,0 +1,438 @@
+// Package indexer watches the Task and AchievementBadge contracts for
+// TaskCreated/TaskCompleted/BadgeMinted events, persists them to an
+// embedded BoltDB store, and serves them back out paginated so handlers
+// like getTasksHandler no longer need an O(n) contract call per page load.
+package indexer
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	eventsBucket = "events"
+	// eventKeysBucket maps "txHash:logIndex" to the eventsBucket ID it was
+	// persisted under, so persist can reject a log it's already seen - a
+	// backfill resuming from the last indexed block, or a reorg replaying
+	// a log, would otherwise duplicate it.
+	eventKeysBucket = "eventKeys"
+	metaBucket      = "meta"
+	lastBlockKey    = "lastIndexedBlock"
+
+	// backfillChunkBlocks bounds how many blocks a single FilterLogs call
+	// spans, so a long backfill doesn't trip provider-side log limits.
+	backfillChunkBlocks = 2000
+)
+
+// Event is a decoded, persisted log from one of the watched contracts.
+type Event struct {
+	ID          uint64                 `json:"id"`
+	Type        string                 `json:"type"`
+	BlockNumber uint64                 `json:"blockNumber"`
+	TxHash      string                 `json:"txHash"`
+	LogIndex    uint                   `json:"logIndex"`
+	Data        map[string]interface{} `json:"data"`
+	IndexedAt   time.Time              `json:"indexedAt"`
+}
+
+// Indexer subscribes to contract logs, persists them, and fans them out to
+// live subscribers (e.g. the /ws eth_subscribe-style endpoint).
+type Indexer struct {
+	client          *ethclient.Client
+	db              *bolt.DB
+	addresses       []common.Address
+	topics          [][]common.Hash
+	decode          func(types.Log) (*Event, error)
+	confirmations   uint64
+
+	mu          sync.RWMutex
+	subscribers map[chan Event]struct{}
+}
+
+// New opens (or creates) the BoltDB store at dbPath and returns an Indexer
+// ready to Start. decode turns a raw log into an Event; confirmations is
+// how many blocks to wait behind the chain head before treating a log as
+// final, to stay reorg-safe.
+func New(client *ethclient.Client, dbPath string, addresses []common.Address, topics [][]common.Hash, confirmations uint64, decode func(types.Log) (*Event, error)) (*Indexer, error) {
+	db, err := bolt.Open(dbPath, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open indexer store: %v", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists([]byte(eventsBucket)); err != nil {
+			return err
+		}
+		if _, err := tx.CreateBucketIfNotExists([]byte(eventKeysBucket)); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists([]byte(metaBucket))
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize indexer buckets: %v", err)
+	}
+
+	return &Indexer{
+		client:        client,
+		db:            db,
+		addresses:     addresses,
+		topics:        topics,
+		decode:        decode,
+		confirmations: confirmations,
+		subscribers:   make(map[chan Event]struct{}),
+	}, nil
+}
+
+// Close releases the underlying BoltDB handle.
+func (idx *Indexer) Close() error {
+	return idx.db.Close()
+}
+
+// Start backfills from just after the last persisted block (or
+// deploymentBlock on a fresh store) up to the confirmed chain head, then
+// keeps indexing live logs until ctx is cancelled. It is meant to run in
+// its own goroutine.
+func (idx *Indexer) Start(ctx context.Context, deploymentBlock int64) error {
+	last, indexed, err := idx.lastIndexedBlock()
+	if err != nil {
+		return err
+	}
+
+	from := uint64(deploymentBlock)
+	if indexed {
+		// last was already fully processed and persisted, so resume
+		// just after it rather than re-processing it.
+		from = last + 1
+	}
+
+	if err := idx.backfill(ctx, from); err != nil {
+		return fmt.Errorf("backfill failed: %v", err)
+	}
+
+	return idx.watch(ctx, from)
+}
+
+// backfill chunks FilterLogs calls from `from` up to (head - confirmations)
+// so a single query never spans an unbounded block range.
+func (idx *Indexer) backfill(ctx context.Context, from uint64) error {
+	header, err := idx.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get chain head: %v", err)
+	}
+
+	head := header.Number.Uint64()
+	if head < idx.confirmations {
+		return nil
+	}
+	confirmedHead := head - idx.confirmations
+
+	for start := from; start <= confirmedHead; start += backfillChunkBlocks {
+		end := start + backfillChunkBlocks - 1
+		if end > confirmedHead {
+			end = confirmedHead
+		}
+
+		query := ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: idx.addresses,
+			Topics:    idx.topics,
+		}
+
+		logs, err := idx.client.FilterLogs(ctx, query)
+		if err != nil {
+			return fmt.Errorf("FilterLogs(%d, %d) failed: %v", start, end, err)
+		}
+
+		for _, l := range logs {
+			if err := idx.persist(l); err != nil {
+				return err
+			}
+		}
+
+		if err := idx.setLastIndexedBlock(end); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// watch subscribes to new logs and applies them once they're behind the
+// confirmation window, so a reorg of the last few blocks doesn't leave a
+// stale event in the store.
+func (idx *Indexer) watch(ctx context.Context, from uint64) error {
+	query := ethereum.FilterQuery{Addresses: idx.addresses, Topics: idx.topics}
+
+	logsCh := make(chan types.Log, 256)
+	sub, err := idx.client.SubscribeFilterLogs(ctx, query, logsCh)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to filter logs: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	catchUp := time.NewTicker(15 * time.Second)
+	defer catchUp.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sub.Err():
+			return fmt.Errorf("log subscription error: %v", err)
+		case <-catchUp.C:
+			// Periodic catch-up backfill for logs skipped below while
+			// they were still inside the confirmation window.
+			if last, indexed, err := idx.lastIndexedBlock(); err == nil && indexed {
+				from = last + 1
+			}
+			idx.backfill(ctx, from)
+		case l := <-logsCh:
+			header, err := idx.client.HeaderByNumber(ctx, nil)
+			if err != nil {
+				continue
+			}
+			if header.Number.Uint64() < l.BlockNumber+idx.confirmations {
+				// Not confirmed yet; the catch-up backfill above will
+				// pick it up once it has enough confirmations behind it.
+				continue
+			}
+			if err := idx.persist(l); err != nil {
+				continue
+			}
+			if err := idx.setLastIndexedBlock(l.BlockNumber); err != nil {
+				continue
+			}
+		}
+	}
+}
+
+// errAlreadyPersisted signals persist found an existing record for the same
+// (txHash, logIndex) and skipped it - not itself a failure.
+var errAlreadyPersisted = errors.New("event already persisted")
+
+func (idx *Indexer) persist(l types.Log) error {
+	event, err := idx.decode(l)
+	if err != nil {
+		return fmt.Errorf("failed to decode log: %v", err)
+	}
+	if event == nil {
+		return nil
+	}
+	event.IndexedAt = time.Now()
+
+	dedupKey := []byte(fmt.Sprintf("%s:%d", event.TxHash, event.LogIndex))
+
+	err = idx.db.Update(func(tx *bolt.Tx) error {
+		keys := tx.Bucket([]byte(eventKeysBucket))
+		if keys.Get(dedupKey) != nil {
+			return errAlreadyPersisted
+		}
+
+		bucket := tx.Bucket([]byte(eventsBucket))
+		id, _ := bucket.NextSequence()
+		event.ID = id
+
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put(idToKey(id), data); err != nil {
+			return err
+		}
+		return keys.Put(dedupKey, idToKey(id))
+	})
+	if errors.Is(err, errAlreadyPersisted) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	idx.broadcast(*event)
+	return nil
+}
+
+// Events returns events newest-first, paginated.
+func (idx *Indexer) Events(page, pageSize int) ([]Event, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	var events []Event
+	skip := (page - 1) * pageSize
+
+	err := idx.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket([]byte(eventsBucket)).Cursor()
+		i := 0
+		for k, v := cursor.Last(); k != nil; k, v = cursor.Prev() {
+			if i < skip {
+				i++
+				continue
+			}
+			if len(events) >= pageSize {
+				break
+			}
+			var e Event
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			events = append(events, e)
+			i++
+		}
+		return nil
+	})
+
+	return events, err
+}
+
+// TaskState is a task's current state derived by folding its
+// TaskCreated/TaskCompleted events together, so getTasksHandler can be
+// served from the store instead of one `tasks(i)` contract call per task.
+type TaskState struct {
+	ID          uint64 `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Reward      string `json:"reward"`
+	Creator     string `json:"creator"`
+	Worker      string `json:"worker"`
+	Completed   bool   `json:"completed"`
+}
+
+// DeriveTasks folds every persisted TaskCreated/TaskCompleted event into
+// current per-task state. It returns an error if no TaskCreated events
+// have been indexed yet, so callers know to fall back to contract calls
+// rather than silently reporting zero tasks.
+func (idx *Indexer) DeriveTasks() ([]TaskState, error) {
+	states := make(map[string]*TaskState)
+	var seenCreated bool
+
+	err := idx.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket([]byte(eventsBucket)).Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			var e Event
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+
+			taskID, ok := e.Data["taskId"].(string)
+			if !ok {
+				continue
+			}
+
+			switch e.Type {
+			case "TaskCreated":
+				seenCreated = true
+				states[taskID] = &TaskState{
+					Title:       fmt.Sprintf("%v", e.Data["title"]),
+					Description: fmt.Sprintf("%v", e.Data["description"]),
+					Reward:      fmt.Sprintf("%v", e.Data["reward"]),
+					Creator:     fmt.Sprintf("%v", e.Data["creator"]),
+				}
+			case "TaskCompleted":
+				if state, ok := states[taskID]; ok {
+					state.Completed = true
+					state.Worker = fmt.Sprintf("%v", e.Data["worker"])
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !seenCreated {
+		return nil, fmt.Errorf("no TaskCreated events indexed yet")
+	}
+
+	tasks := make([]TaskState, 0, len(states))
+	for id, state := range states {
+		// id is the 0x-prefixed topic hex Decode stores for indexed args.
+		var numericID uint64
+		if n, ok := new(big.Int).SetString(id, 0); ok {
+			numericID = n.Uint64()
+		}
+		state.ID = numericID
+		tasks = append(tasks, *state)
+	}
+	return tasks, nil
+}
+
+// Subscribe registers a channel that receives every newly persisted event.
+// The returned func unregisters it.
+func (idx *Indexer) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, 32)
+
+	idx.mu.Lock()
+	idx.subscribers[ch] = struct{}{}
+	idx.mu.Unlock()
+
+	return ch, func() {
+		idx.mu.Lock()
+		delete(idx.subscribers, ch)
+		idx.mu.Unlock()
+		close(ch)
+	}
+}
+
+// lastIndexedBlock returns the last block persisted via setLastIndexedBlock
+// and whether one has ever been stored - block 0 is a valid deployment
+// block, so a bare uint64 return can't distinguish "never indexed" from
+// "indexed through block 0" on its own.
+func (idx *Indexer) lastIndexedBlock() (last uint64, indexed bool, err error) {
+	err = idx.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket([]byte(metaBucket)).Get([]byte(lastBlockKey))
+		if v == nil {
+			return nil
+		}
+		last = binary.BigEndian.Uint64(v)
+		indexed = true
+		return nil
+	})
+	return last, indexed, err
+}
+
+func (idx *Indexer) setLastIndexedBlock(block uint64) error {
+	return idx.db.Update(func(tx *bolt.Tx) error {
+		buf := make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, block)
+		return tx.Bucket([]byte(metaBucket)).Put([]byte(lastBlockKey), buf)
+	})
+}
+
+func idToKey(id uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, id)
+	return buf
+}
+
+// broadcast pushes event to every live subscriber without blocking on a
+// slow or abandoned reader.
+func (idx *Indexer) broadcast(event Event) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	for ch := range idx.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package artifacts
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// NewFromEnv builds the configured Store: a MinIOStore when
+// ARTIFACTS_ENDPOINT and ARTIFACTS_BUCKET are both set (with
+// ARTIFACTS_ACCESS_KEY, ARTIFACTS_SECRET_KEY, and ARTIFACTS_USE_SSL),
+// otherwise a DiskStore rooted at ARTIFACTS_DISK_DIR (default
+// ./artifacts), so a deployment without object storage configured still
+// keeps every artifact instead of failing to save them.
+func NewFromEnv(ctx context.Context) (Store, error) {
+	endpoint := os.Getenv("ARTIFACTS_ENDPOINT")
+	bucket := os.Getenv("ARTIFACTS_BUCKET")
+
+	if endpoint == "" || bucket == "" {
+		dir := os.Getenv("ARTIFACTS_DISK_DIR")
+		if dir == "" {
+			dir = "./artifacts"
+		}
+		return NewDiskStore(dir), nil
+	}
+
+	useSSL, _ := strconv.ParseBool(os.Getenv("ARTIFACTS_USE_SSL"))
+	store, err := NewMinIOStore(ctx,
+		endpoint,
+		os.Getenv("ARTIFACTS_ACCESS_KEY"),
+		os.Getenv("ARTIFACTS_SECRET_KEY"),
+		bucket,
+		useSSL,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure object storage, check ARTIFACTS_* env vars: %v", err)
+	}
+	return store, nil
+}
@@ -0,0 +1,47 @@
+package artifacts
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DiskStore saves artifacts under a local directory. It's the fallback
+// Store when object storage isn't configured.
+type DiskStore struct {
+	baseDir string
+}
+
+// NewDiskStore roots a DiskStore at baseDir.
+func NewDiskStore(baseDir string) *DiskStore {
+	return &DiskStore{baseDir: baseDir}
+}
+
+// Put writes data to baseDir/key, creating parent directories as needed.
+// Callers are expected to validate key themselves (see SaveTask's
+// validTaskID), but Put re-checks that the resolved path still lands
+// under baseDir as defense in depth against a key like "../../etc/passwd".
+func (s *DiskStore) Put(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	base, err := filepath.Abs(s.baseDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve artifact base directory: %v", err)
+	}
+	path, err := filepath.Abs(filepath.Join(base, filepath.FromSlash(key)))
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve artifact path: %v", err)
+	}
+	if path != base && !strings.HasPrefix(path, base+string(filepath.Separator)) {
+		return "", fmt.Errorf("artifact key %q escapes the artifact directory", key)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create artifact directory: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", fmt.Errorf("failed to write artifact %s: %v", path, err)
+	}
+
+	return "file://" + path, nil
+}
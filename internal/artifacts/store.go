@@ -0,0 +1,13 @@
+// Package artifacts persists the inputs and outputs of a GraphRAG run -
+// the task request, raw output, and parsed response - for auditability
+// and so a better parser can be re-run against historical output without
+// re-running GraphRAG itself.
+package artifacts
+
+import "context"
+
+// Store saves raw bytes under key and returns a URL clients can use to
+// fetch them back.
+type Store interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) (string, error)
+}
@@ -0,0 +1,60 @@
+package artifacts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Al3x-Myku/Antigel/internal/selector"
+)
+
+// validTaskID matches the task IDs SaveTask will accept. taskID ends up in
+// a storage key (and, for DiskStore, a filesystem path), so anything that
+// isn't a plain identifier - "../../etc/passwd", say - is rejected here
+// rather than trusted.
+var validTaskID = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// SaveTask persists task's input, raw GraphRAG output (when the Selector
+// produced one), and parsed response under a deterministic
+// tasks/{id}/{timestamp}/{artifact}.json key, returning the URL of each
+// artifact that was saved.
+func SaveTask(ctx context.Context, store Store, taskID string, task selector.TaskRequest, raw []byte, response *selector.GraphRAGResponse) (map[string]string, error) {
+	if !validTaskID.MatchString(taskID) {
+		return nil, fmt.Errorf("invalid task id %q: must match %s", taskID, validTaskID.String())
+	}
+
+	prefix := fmt.Sprintf("tasks/%s/%d", taskID, time.Now().Unix())
+	urls := make(map[string]string)
+
+	taskJSON, err := json.Marshal(task)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task: %v", err)
+	}
+	taskURL, err := store.Put(ctx, prefix+"/task.json", taskJSON, "application/json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to save task artifact: %v", err)
+	}
+	urls["task"] = taskURL
+
+	if len(raw) > 0 {
+		rawURL, err := store.Put(ctx, prefix+"/raw_output.json", raw, "application/json")
+		if err != nil {
+			return nil, fmt.Errorf("failed to save raw output artifact: %v", err)
+		}
+		urls["raw_output"] = rawURL
+	}
+
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %v", err)
+	}
+	responseURL, err := store.Put(ctx, prefix+"/response.json", responseJSON, "application/json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to save response artifact: %v", err)
+	}
+	urls["response"] = responseURL
+
+	return urls, nil
+}
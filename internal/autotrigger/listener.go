@@ -0,0 +1,249 @@
+// Package autotrigger watches Firestore for tasks awaiting GraphRAG
+// selection and enqueues them automatically, so a client only has to
+// write a task document with status "pending" and never has to call
+// POST /process-task directly.
+package autotrigger
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Al3x-Myku/Antigel/internal/selector"
+	"github.com/Al3x-Myku/Antigel/internal/telemetry"
+)
+
+const (
+	tasksCollection  = "tasks"
+	pendingStatus    = "pending"
+	processingStatus = "processing"
+	leaderDocPath    = "system/_leader"
+	leaderLeaseTTL   = 30 * time.Second
+	leaderRenewEvery = 10 * time.Second
+	leaderRetryEvery = 5 * time.Second
+)
+
+// errNotLeader marks a leadership transaction that lost to another
+// instance's still-valid lease, as opposed to a real Firestore error.
+var errNotLeader = fmt.Errorf("another instance holds the task listener lease")
+
+// EnqueueFunc enqueues a task for background GraphRAG processing; it's
+// jobqueue.Client.Enqueue, passed in rather than imported directly so this
+// package doesn't need to know about asynq.
+type EnqueueFunc func(ctx context.Context, task selector.TaskRequest) (string, error)
+
+// leaderState is the system/_leader document: whichever instance holds a
+// still-valid lease on it is the one allowed to watch Firestore and
+// enqueue tasks, so multiple replicas of the service don't all claim and
+// process the same task document.
+type leaderState struct {
+	Holder    string    `firestore:"holder"`
+	ExpiresAt time.Time `firestore:"expires_at"`
+}
+
+// Listener watches the tasks collection for pending documents and
+// enqueues a GraphRAG job for each one it claims.
+type Listener struct {
+	client     *firestore.Client
+	enqueue    EnqueueFunc
+	instanceID string
+}
+
+// NewListener builds a Listener. instanceID identifies this process in
+// leader election (e.g. "host:pid") and must be unique per running
+// instance of the service.
+func NewListener(client *firestore.Client, enqueue EnqueueFunc, instanceID string) *Listener {
+	return &Listener{client: client, enqueue: enqueue, instanceID: instanceID}
+}
+
+// Run blocks, alternating between trying to acquire the listener lease
+// and, once held, watching for pending tasks until the lease is lost or
+// ctx is canceled.
+func (l *Listener) Run(ctx context.Context) {
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if !l.acquireLeadership(ctx) {
+			time.Sleep(leaderRetryEvery)
+			continue
+		}
+
+		telemetry.Log.Info("acquired task listener leadership", zap.String("instance_id", l.instanceID))
+		if err := l.watch(ctx); err != nil && ctx.Err() == nil {
+			telemetry.Log.Warn("task listener stopped, will retry", zap.String("instance_id", l.instanceID), zap.Error(err))
+		}
+	}
+}
+
+// acquireLeadership claims (or renews) the listener lease for l.instanceID,
+// via a transaction so two instances racing to claim an expired lease
+// can't both believe they won.
+func (l *Listener) acquireLeadership(ctx context.Context) bool {
+	ref := l.client.Doc(leaderDocPath)
+
+	err := l.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		now := time.Now()
+		snap, err := tx.Get(ref)
+		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				return err
+			}
+			return tx.Set(ref, leaderState{Holder: l.instanceID, ExpiresAt: now.Add(leaderLeaseTTL)})
+		}
+
+		var state leaderState
+		if err := snap.DataTo(&state); err != nil {
+			return err
+		}
+		if state.Holder != l.instanceID && now.Before(state.ExpiresAt) {
+			return errNotLeader
+		}
+		return tx.Set(ref, leaderState{Holder: l.instanceID, ExpiresAt: now.Add(leaderLeaseTTL)})
+	})
+
+	if err != nil {
+		if err != errNotLeader {
+			telemetry.Log.Warn("task listener leader election failed", zap.Error(err))
+		}
+		return false
+	}
+	return true
+}
+
+// watch opens a Snapshots listener on pending tasks and claims each one it
+// sees, for as long as this instance keeps renewing its lease.
+func (l *Listener) watch(ctx context.Context) error {
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go l.renewLeaseUntilLost(watchCtx, cancel)
+
+	it := l.client.Collection(tasksCollection).Where("status", "==", pendingStatus).Snapshots(watchCtx)
+	defer it.Stop()
+
+	for {
+		snap, err := it.Next()
+		if err != nil {
+			return err
+		}
+		for _, change := range snap.Changes {
+			if change.Kind == firestore.DocumentRemoved {
+				continue
+			}
+			l.claim(ctx, change.Doc)
+		}
+	}
+}
+
+// renewLeaseUntilLost keeps the lease alive while watch runs, and cancels
+// cancel (stopping the snapshot listener) the moment it can't.
+func (l *Listener) renewLeaseUntilLost(ctx context.Context, cancel context.CancelFunc) {
+	ticker := time.NewTicker(leaderRenewEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !l.acquireLeadership(ctx) {
+				telemetry.Log.Warn("lost task listener leadership", zap.String("instance_id", l.instanceID))
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// claim builds a TaskRequest from doc, atomically marks it "processing" so
+// no other replica (or a future snapshot re-delivery of this same change)
+// enqueues it again, and hands it to enqueue.
+func (l *Listener) claim(ctx context.Context, doc *firestore.DocumentSnapshot) {
+	task, err := taskFromSnapshot(doc)
+	if err != nil {
+		telemetry.Log.Warn("skipping malformed pending task document", zap.String("doc_id", doc.Ref.ID), zap.Error(err))
+		return
+	}
+
+	claimed, err := l.claimDocument(ctx, doc.Ref)
+	if err != nil {
+		telemetry.Log.Warn("failed to claim pending task", zap.String("task_id", task.ID), zap.Error(err))
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	jobID, err := l.enqueue(ctx, task)
+	if err != nil {
+		telemetry.Log.Error("failed to enqueue auto-triggered task", zap.String("task_id", task.ID), zap.Error(err))
+		return
+	}
+	telemetry.Log.Info("auto-triggered task from Firestore", zap.String("task_id", task.ID), zap.String("job_id", jobID))
+}
+
+// claimDocument atomically flips ref's status from "pending" to
+// "processing", reporting false (not an error) if it had already moved
+// off "pending" by the time the transaction ran.
+func (l *Listener) claimDocument(ctx context.Context, ref *firestore.DocumentRef) (bool, error) {
+	claimed := false
+	err := l.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snap, err := tx.Get(ref)
+		if err != nil {
+			return err
+		}
+		if status, _ := snap.Data()["status"].(string); status != pendingStatus {
+			return nil
+		}
+		claimed = true
+		return tx.Update(ref, []firestore.Update{
+			{Path: "status", Value: processingStatus},
+			{Path: "updated_at", Value: time.Now()},
+		})
+	})
+	return claimed, err
+}
+
+// taskFromSnapshot builds a selector.TaskRequest from a tasks/{id}
+// document. Firestore documents here use the same field names as the
+// JSON wire format (see selector.TaskRequest's tags), so fields are read
+// directly out of the raw map rather than via DataTo, which would expect
+// Go-style field names absent an explicit `firestore` tag on every field.
+func taskFromSnapshot(doc *firestore.DocumentSnapshot) (selector.TaskRequest, error) {
+	data := doc.Data()
+
+	task := selector.TaskRequest{ID: doc.Ref.ID}
+	task.Title, _ = data["title"].(string)
+	task.Description, _ = data["description"].(string)
+	task.Requirements = stringSlice(data["requirements"])
+	task.Skills = stringSlice(data["skills"])
+	task.Reward, _ = data["reward"].(float64)
+	task.Deadline, _ = data["deadline"].(string)
+	task.Creator, _ = data["creator"].(string)
+
+	if task.Title == "" {
+		return task, fmt.Errorf("task document %s is missing a title", doc.Ref.ID)
+	}
+	return task, nil
+}
+
+func stringSlice(v interface{}) []string {
+	items, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
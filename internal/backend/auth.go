@@ -0,0 +1,366 @@
+package backend
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// jwtSecret signs and verifies session cookies. Serve sets it from the
+// --jwt-secret flag (env ANTIGEL_JWT_SECRET) before registering routes.
+var jwtSecret []byte
+
+const (
+	sessionCookieName = "antigel_session"
+	sessionTTL        = 24 * time.Hour
+	nonceTTL          = 5 * time.Minute
+)
+
+// networkChainIDs maps the deployment network name to the EIP-155 chain ID
+// a SIWE message must declare.
+var networkChainIDs = map[string]int64{
+	"mainnet": 1,
+	"sepolia": 11155111,
+	"local":   1337,
+}
+
+// nonceStore hands out random, single-use SIWE nonces and forgets them
+// after nonceTTL. Consuming a nonce deletes it, so a captured SIWE message
+// cannot be replayed once its sign-in has completed.
+type nonceStore struct {
+	mu     sync.Mutex
+	expiry map[string]time.Time
+}
+
+var nonces = &nonceStore{expiry: make(map[string]time.Time)}
+
+// generate mints a new nonce and evicts any that have expired.
+func (s *nonceStore) generate() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for n, exp := range s.expiry {
+		if now.After(exp) {
+			delete(s.expiry, n)
+		}
+	}
+
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %v", err)
+	}
+
+	nonce := hex.EncodeToString(raw)
+	s.expiry[nonce] = now.Add(nonceTTL)
+	return nonce, nil
+}
+
+// consume reports whether nonce is known and unexpired, and deletes it
+// either way so it can never be presented a second time.
+func (s *nonceStore) consume(nonce string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exp, ok := s.expiry[nonce]
+	delete(s.expiry, nonce)
+	return ok && time.Now().Before(exp)
+}
+
+// siweMessage is the subset of an EIP-4361 "Sign-In with Ethereum" message
+// this backend validates.
+type siweMessage struct {
+	Domain         string
+	Address        common.Address
+	ChainID        int64
+	Nonce          string
+	ExpirationTime time.Time
+}
+
+// parseSIWEMessage parses the plain-text SIWE message format from EIP-4361:
+//
+//	<domain> wants you to sign in with your Ethereum account:
+//	<address>
+//
+//	<statement>
+//
+//	URI: <uri>
+//	Version: <version>
+//	Chain ID: <chain-id>
+//	Nonce: <nonce>
+//	Issued At: <issued-at>
+//	Expiration Time: <expiration-time>
+func parseSIWEMessage(raw string) (*siweMessage, error) {
+	lines := strings.Split(raw, "\n")
+	if len(lines) < 2 {
+		return nil, errors.New("message is too short to be a SIWE message")
+	}
+
+	const header = " wants you to sign in with your Ethereum account:"
+	if !strings.HasSuffix(lines[0], header) {
+		return nil, errors.New("missing SIWE domain header")
+	}
+	domain := strings.TrimSuffix(lines[0], header)
+
+	addressLine := strings.TrimSpace(lines[1])
+	if !common.IsHexAddress(addressLine) {
+		return nil, fmt.Errorf("invalid address %q", addressLine)
+	}
+
+	msg := &siweMessage{Domain: domain, Address: common.HexToAddress(addressLine)}
+	for _, line := range lines[2:] {
+		switch {
+		case strings.HasPrefix(line, "Chain ID: "):
+			id, err := strconv.ParseInt(strings.TrimPrefix(line, "Chain ID: "), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid chain ID: %v", err)
+			}
+			msg.ChainID = id
+		case strings.HasPrefix(line, "Nonce: "):
+			msg.Nonce = strings.TrimPrefix(line, "Nonce: ")
+		case strings.HasPrefix(line, "Expiration Time: "):
+			t, err := time.Parse(time.RFC3339, strings.TrimPrefix(line, "Expiration Time: "))
+			if err != nil {
+				return nil, fmt.Errorf("invalid expiration time: %v", err)
+			}
+			msg.ExpirationTime = t
+		}
+	}
+
+	if msg.Nonce == "" {
+		return nil, errors.New("message is missing a nonce")
+	}
+
+	return msg, nil
+}
+
+// validate checks the domain/chainId/expiry/nonce fields of an already-parsed
+// message and, on success, consumes its nonce so it cannot be reused.
+func (m *siweMessage) validate(expectedDomain string, expectedChainID int64) error {
+	if m.Domain != expectedDomain {
+		return fmt.Errorf("domain mismatch: message is for %q", m.Domain)
+	}
+	if m.ChainID != expectedChainID {
+		return fmt.Errorf("chain ID mismatch: message is for chain %d", m.ChainID)
+	}
+	if !m.ExpirationTime.IsZero() && time.Now().After(m.ExpirationTime) {
+		return errors.New("message has expired")
+	}
+	if !nonces.consume(m.Nonce) {
+		return errors.New("nonce is unknown, expired, or already used")
+	}
+	return nil
+}
+
+// recoverSigner recovers the address that produced signature over message
+// via personal_sign (accounts.TextHash), the scheme wallets use to sign
+// SIWE messages.
+func recoverSigner(message string, signature []byte) (common.Address, error) {
+	if len(signature) != 65 {
+		return common.Address{}, fmt.Errorf("invalid signature length: %d", len(signature))
+	}
+
+	sig := make([]byte, 65)
+	copy(sig, signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(accounts.TextHash([]byte(message)), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover signer: %v", err)
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// sessionClaims is the JWT payload stored in the session cookie.
+type sessionClaims struct {
+	Address string `json:"address"`
+	jwt.RegisteredClaims
+}
+
+// issueSession signs a session JWT for address and sets it as an HttpOnly
+// cookie.
+func issueSession(w http.ResponseWriter, address common.Address) error {
+	now := time.Now()
+	claims := sessionClaims{
+		Address: address.Hex(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(sessionTTL)),
+		},
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(jwtSecret)
+	if err != nil {
+		return fmt.Errorf("failed to sign session token: %v", err)
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    signed,
+		Path:     "/",
+		Expires:  now.Add(sessionTTL),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+// authContextKey namespaces values this package stores on a request context.
+type authContextKey string
+
+const authenticatedAddressKey authContextKey = "authenticatedAddress"
+
+// addressFromContext returns the address requireAuth/withOptionalSession
+// attached to ctx, if any.
+func addressFromContext(ctx context.Context) (common.Address, bool) {
+	addr, ok := ctx.Value(authenticatedAddressKey).(common.Address)
+	return addr, ok
+}
+
+// addressFromRequest returns the address requireAuth attached to the
+// request, if any.
+func addressFromRequest(r *http.Request) (common.Address, bool) {
+	return addressFromContext(r.Context())
+}
+
+// sessionAddress validates r's session cookie, if it has one, and returns
+// the address it authenticates.
+func sessionAddress(r *http.Request) (common.Address, bool) {
+	cookie, err := r.Cookie(sessionCookieName)
+	if err != nil {
+		return common.Address{}, false
+	}
+
+	var claims sessionClaims
+	token, err := jwt.ParseWithClaims(cookie.Value, &claims, func(t *jwt.Token) (interface{}, error) {
+		return jwtSecret, nil
+	})
+	if err != nil || !token.Valid || !common.IsHexAddress(claims.Address) {
+		return common.Address{}, false
+	}
+	return common.HexToAddress(claims.Address), true
+}
+
+// requireAuth wraps a handler so it only runs once the session cookie has
+// been validated, with the authenticated address injected into the request
+// context for the handler to read via addressFromRequest.
+func requireAuth(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions {
+			next(w, r)
+			return
+		}
+
+		address, ok := sessionAddress(r)
+		if !ok {
+			writeError(w, "invalid or expired session", http.StatusUnauthorized)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), authenticatedAddressKey, address)
+		next(w, r.WithContext(ctx))
+	}
+}
+
+// withOptionalSession attaches the authenticated address to the request
+// context when a valid session cookie is present, but - unlike requireAuth
+// - doesn't reject a request that has none. It's for the JSON-RPC
+// endpoint, which serves a mix of public methods (net_version, ...) and
+// session-gated ones (SidequestsAPI.GetUserStats/GetNFTMetadata) behind a
+// single HTTP route; the gated methods enforce the session themselves via
+// addressFromContext.
+func withOptionalSession(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if address, ok := sessionAddress(r); ok {
+			r = r.WithContext(context.WithValue(r.Context(), authenticatedAddressKey, address))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// authNonceHandler issues a fresh SIWE nonce at GET /api/auth/nonce.
+func authNonceHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	nonce, err := nonces.generate()
+	if err != nil {
+		writeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeSuccess(w, map[string]string{"nonce": nonce}, "Nonce issued")
+}
+
+// authVerifyRequest is the POST body accepted by /api/auth/verify.
+type authVerifyRequest struct {
+	Message   string `json:"message"`
+	Signature string `json:"signature"`
+}
+
+// authVerifyHandler validates a signed SIWE message at POST /api/auth/verify
+// and, on success, starts a session.
+func authVerifyHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	var req authVerifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	message, err := parseSIWEMessage(req.Message)
+	if err != nil {
+		writeError(w, fmt.Sprintf("invalid SIWE message: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := message.validate(r.Host, networkChainIDs[deploymentData.Network]); err != nil {
+		writeError(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	signature, err := hexutil.Decode(req.Signature)
+	if err != nil {
+		writeError(w, "invalid signature encoding", http.StatusBadRequest)
+		return
+	}
+
+	signer, err := recoverSigner(req.Message, signature)
+	if err != nil {
+		writeError(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if signer != message.Address {
+		writeError(w, "signature does not match the message's address", http.StatusUnauthorized)
+		return
+	}
+
+	if err := issueSession(w, signer); err != nil {
+		writeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeSuccess(w, map[string]string{"address": signer.Hex()}, "Signed in")
+}
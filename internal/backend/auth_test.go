@@ -0,0 +1,172 @@
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestNonceStoreConsumeIsSingleUse(t *testing.T) {
+	s := &nonceStore{expiry: make(map[string]time.Time)}
+	s.expiry["abc"] = time.Now().Add(nonceTTL)
+
+	if !s.consume("abc") {
+		t.Fatal("consume of a fresh nonce should succeed")
+	}
+	if s.consume("abc") {
+		t.Fatal("consume of an already-consumed nonce should fail (replay)")
+	}
+}
+
+func TestNonceStoreConsumeRejectsExpired(t *testing.T) {
+	s := &nonceStore{expiry: make(map[string]time.Time)}
+	s.expiry["abc"] = time.Now().Add(-time.Second)
+
+	if s.consume("abc") {
+		t.Fatal("consume of an expired nonce should fail")
+	}
+}
+
+func TestNonceStoreConsumeRejectsUnknown(t *testing.T) {
+	s := &nonceStore{expiry: make(map[string]time.Time)}
+
+	if s.consume("never-issued") {
+		t.Fatal("consume of a nonce that was never generated should fail")
+	}
+}
+
+func validSIWEMessage(t *testing.T, address, domain string, nonce string, expiration time.Time) *siweMessage {
+	t.Helper()
+	return &siweMessage{
+		Domain:         domain,
+		Address:        common.HexToAddress(address),
+		ChainID:        11155111,
+		Nonce:          nonce,
+		ExpirationTime: expiration,
+	}
+}
+
+func TestSIWEMessageValidateRejectsReplayedNonce(t *testing.T) {
+	nonces = &nonceStore{expiry: make(map[string]time.Time)}
+	nonces.expiry["n1"] = time.Now().Add(nonceTTL)
+
+	msg := validSIWEMessage(t, "0x0000000000000000000000000000000000000001", "example.com", "n1", time.Now().Add(time.Hour))
+
+	if err := msg.validate("example.com", 11155111); err != nil {
+		t.Fatalf("first validate should succeed, got: %v", err)
+	}
+	if err := msg.validate("example.com", 11155111); err == nil {
+		t.Fatal("second validate with the same nonce should fail (replay)")
+	}
+}
+
+func TestSIWEMessageValidateRejectsExpiredMessage(t *testing.T) {
+	nonces = &nonceStore{expiry: make(map[string]time.Time)}
+	nonces.expiry["n1"] = time.Now().Add(nonceTTL)
+
+	msg := validSIWEMessage(t, "0x0000000000000000000000000000000000000001", "example.com", "n1", time.Now().Add(-time.Minute))
+
+	if err := msg.validate("example.com", 11155111); err == nil {
+		t.Fatal("validate should reject a message whose expiration time has passed")
+	}
+}
+
+func TestSIWEMessageValidateRejectsWrongDomain(t *testing.T) {
+	nonces = &nonceStore{expiry: make(map[string]time.Time)}
+	nonces.expiry["n1"] = time.Now().Add(nonceTTL)
+
+	msg := validSIWEMessage(t, "0x0000000000000000000000000000000000000001", "attacker.example", "n1", time.Now().Add(time.Hour))
+
+	if err := msg.validate("example.com", 11155111); err == nil {
+		t.Fatal("validate should reject a message signed for a different domain")
+	}
+}
+
+func TestSIWEMessageValidateRejectsWrongChainID(t *testing.T) {
+	nonces = &nonceStore{expiry: make(map[string]time.Time)}
+	nonces.expiry["n1"] = time.Now().Add(nonceTTL)
+
+	msg := validSIWEMessage(t, "0x0000000000000000000000000000000000000001", "example.com", "n1", time.Now().Add(time.Hour))
+
+	if err := msg.validate("example.com", 1); err == nil {
+		t.Fatal("validate should reject a message signed for a different chain ID")
+	}
+}
+
+func TestRecoverSignerMatchesSigningKey(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate test key: %v", err)
+	}
+	want := crypto.PubkeyToAddress(key.PublicKey)
+
+	message := "example.com wants you to sign in with your Ethereum account:\n" + want.Hex() + "\n\nNonce: abc\n"
+	signature, err := crypto.Sign(accounts.TextHash([]byte(message)), key)
+	if err != nil {
+		t.Fatalf("failed to sign test message: %v", err)
+	}
+
+	got, err := recoverSigner(message, signature)
+	if err != nil {
+		t.Fatalf("recoverSigner returned an error: %v", err)
+	}
+	if got != want {
+		t.Fatalf("recoverSigner returned %s, want %s", got.Hex(), want.Hex())
+	}
+}
+
+func TestRecoverSignerRejectsWrongSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate test key: %v", err)
+	}
+	otherKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate second test key: %v", err)
+	}
+
+	message := "example.com wants you to sign in with your Ethereum account:\n" + crypto.PubkeyToAddress(key.PublicKey).Hex() + "\n\nNonce: abc\n"
+	signature, err := crypto.Sign(accounts.TextHash([]byte(message)), otherKey)
+	if err != nil {
+		t.Fatalf("failed to sign test message: %v", err)
+	}
+
+	got, err := recoverSigner(message, signature)
+	if err != nil {
+		t.Fatalf("recoverSigner returned an error: %v", err)
+	}
+	if got == crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatal("recoverSigner should not recover the claimed address when signed by a different key")
+	}
+}
+
+func TestParseSIWEMessageExtractsFields(t *testing.T) {
+	raw := "example.com wants you to sign in with your Ethereum account:\n" +
+		"0x0000000000000000000000000000000000000001\n" +
+		"\n" +
+		"Sign in to SideQuests.\n" +
+		"\n" +
+		"URI: https://example.com\n" +
+		"Version: 1\n" +
+		"Chain ID: 11155111\n" +
+		"Nonce: abc123\n" +
+		"Issued At: 2026-01-01T00:00:00Z\n" +
+		"Expiration Time: 2026-01-01T01:00:00Z\n"
+
+	msg, err := parseSIWEMessage(raw)
+	if err != nil {
+		t.Fatalf("parseSIWEMessage returned an error: %v", err)
+	}
+	if msg.Domain != "example.com" {
+		t.Errorf("Domain = %q, want %q", msg.Domain, "example.com")
+	}
+	if msg.ChainID != 11155111 {
+		t.Errorf("ChainID = %d, want %d", msg.ChainID, 11155111)
+	}
+	if msg.Nonce != "abc123" {
+		t.Errorf("Nonce = %q, want %q", msg.Nonce, "abc123")
+	}
+}
@@ -0,0 +1,120 @@
+package backend
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/Al3x-Myku/Antigel/indexer"
+)
+
+// confirmationDelay is how many blocks the indexer waits behind the chain
+// head before treating a log as final (reorg safety).
+const confirmationDelay = 5
+
+// taskIndexer is populated by startIndexer during application init and
+// read by fetchTasks/getEventsHandler/EventsAPI.
+var taskIndexer *indexer.Indexer
+
+// startIndexer builds the Task/AchievementBadge event indexer and runs its
+// backfill-then-watch loop in the background until ctx is cancelled.
+func startIndexer(ctx context.Context) error {
+	taskABI, err := abi.JSON(strings.NewReader(string(deploymentData.TaskContract.ABI)))
+	if err != nil {
+		return err
+	}
+	badgeABI, err := abi.JSON(strings.NewReader(string(deploymentData.AchievementBadgeContract.ABI)))
+	if err != nil {
+		return err
+	}
+
+	topics, err := indexer.Topics(taskABI, badgeABI)
+	if err != nil {
+		return err
+	}
+
+	addresses := []common.Address{
+		common.HexToAddress(deploymentData.TaskContract.Address),
+		common.HexToAddress(deploymentData.AchievementBadgeContract.Address),
+	}
+
+	idx, err := indexer.New(ethClient, "antigel_events.db", addresses, topics, confirmationDelay, indexer.Decode(taskABI, badgeABI))
+	if err != nil {
+		return err
+	}
+	taskIndexer = idx
+
+	go func() {
+		if err := idx.Start(ctx, deploymentData.DeploymentBlock); err != nil {
+			log.Printf("⚠️ event indexer stopped: %v", err)
+		}
+	}()
+
+	return nil
+}
+
+// getEventsHandler serves paginated indexed events at GET /api/events.
+func getEventsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	if taskIndexer == nil {
+		writeError(w, "event indexer is not running", http.StatusServiceUnavailable)
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+	events, err := taskIndexer.Events(page, pageSize)
+	if err != nil {
+		writeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeSuccess(w, events, "Events retrieved")
+}
+
+// EventsAPI exposes the events_ namespace: an eth_subscribe-style live feed
+// of newly indexed task/badge activity over the /ws WebSocket endpoint.
+type EventsAPI struct{}
+
+// NewEvents streams every newly indexed event to the subscriber until the
+// connection closes. It follows the same subscription pattern as
+// go-ethereum's own eth_subscribe (a *rpc.Notifier pulled from ctx).
+func (e *EventsAPI) NewEvents(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	if taskIndexer == nil {
+		return nil, rpc.ErrSubscriptionNotFound
+	}
+
+	subscription := notifier.CreateSubscription()
+
+	events, unsubscribe := taskIndexer.Subscribe()
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case event := <-events:
+				if err := notifier.Notify(subscription.ID, event); err != nil {
+					return
+				}
+			case <-subscription.Err():
+				return
+			}
+		}
+	}()
+
+	return subscription, nil
+}
@@ -0,0 +1,102 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// antigelClientVersion is returned by web3_clientVersion.
+const antigelClientVersion = "antigel/1.0.0"
+
+// SidequestsAPI exposes the sidequests_ namespace: the application-specific
+// methods that used to live only behind REST (/api/tasks, /api/users/stats,
+// /api/nft/metadata).
+type SidequestsAPI struct{}
+
+func (s *SidequestsAPI) GetTasks(ctx context.Context) (TaskResponse, error) {
+	return fetchTasks(ctx)
+}
+
+func (s *SidequestsAPI) GetUserStats(ctx context.Context) (UserStatsResponse, error) {
+	address, ok := addressFromContext(ctx)
+	if !ok {
+		return UserStatsResponse{}, errors.New("authentication required")
+	}
+	return fetchUserStats(ctx, address)
+}
+
+func (s *SidequestsAPI) GetNFTMetadata(ctx context.Context, tokenID hexutil.Uint64) (*NFTMetadataResult, error) {
+	if _, ok := addressFromContext(ctx); !ok {
+		return nil, errors.New("authentication required")
+	}
+	return fetchNFTMetadata(ctx, int64(tokenID))
+}
+
+// NetAPI exposes the net_ namespace, mirroring go-ethereum's own net
+// service so wallet libraries that already speak JSON-RPC feel at home.
+type NetAPI struct{}
+
+func (n *NetAPI) Version() string {
+	return deploymentData.Network
+}
+
+func (n *NetAPI) Listening() bool {
+	return ethClient != nil
+}
+
+// Web3API exposes the web3_ namespace.
+type Web3API struct{}
+
+func (w *Web3API) ClientVersion() string {
+	return antigelClientVersion
+}
+
+func (w *Web3API) Sha3(input hexutil.Bytes) hexutil.Bytes {
+	return crypto.Keccak256(input)
+}
+
+// newRPCServer registers the sidequests/net/web3/events namespaces on a fresh
+// go-ethereum *rpc.Server, ready to be served over HTTP and WebSocket.
+func newRPCServer() (*rpc.Server, error) {
+	server := rpc.NewServer()
+
+	apis := []rpc.API{
+		{Namespace: "sidequests", Service: &SidequestsAPI{}},
+		{Namespace: "net", Service: &NetAPI{}},
+		{Namespace: "web3", Service: &Web3API{}},
+		{Namespace: "events", Service: &EventsAPI{}},
+	}
+
+	for _, api := range apis {
+		if err := server.RegisterName(api.Namespace, api.Service); err != nil {
+			return nil, fmt.Errorf("failed to register %s API: %v", api.Namespace, err)
+		}
+	}
+
+	return server, nil
+}
+
+// corsHandler wraps an *rpc.Server with the same permissive CORS policy the
+// REST routes use, and handles the browser preflight OPTIONS request. It
+// also runs withOptionalSession so the sidequests_ methods that require a
+// session (GetUserStats, GetNFTMetadata) can read the caller's authenticated
+// address via addressFromContext instead of trusting a caller-supplied one.
+func corsHandler(server *rpc.Server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		withOptionalSession(server).ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,691 @@
+// Package backend implements the SideQuests HTTP/JSON-RPC server: the
+// `antigel serve` subcommand is a thin wrapper around Serve.
+package backend
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/Al3x-Myku/Antigel/contracts"
+	"github.com/Al3x-Myku/Antigel/internal/config"
+)
+
+// Global configuration, populated by Serve/initializeApp.
+var (
+	ethClient      *ethclient.Client
+	deploymentData DeploymentConfig
+)
+
+// Deployment configuration structure
+type DeploymentConfig struct {
+	Network                  string       `json:"network"`
+	Deployer                 string       `json:"deployer"`
+	RewardContract           ContractInfo `json:"rewardContract"`
+	TaskContract             ContractInfo `json:"taskContract"`
+	AchievementBadgeContract ContractInfo `json:"achievementBadgeContract"`
+	DeploymentBlock          int64        `json:"deploymentBlock"`
+}
+
+type ContractInfo struct {
+	Address string          `json:"address"`
+	ABI     json.RawMessage `json:"abi"`
+}
+
+// Task structure matching the smart contract
+type Task struct {
+	ID          *big.Int `json:"id"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Reward      *big.Int `json:"reward"`
+	Completed   bool     `json:"completed"`
+	Worker      string   `json:"worker"`
+	Creator     string   `json:"creator"`
+}
+
+// API response structures
+type APIResponse struct {
+	Success     bool        `json:"success"`
+	Data        interface{} `json:"data,omitempty"`
+	Error       string      `json:"error,omitempty"`
+	Message     string      `json:"message,omitempty"`
+	BlockNumber string      `json:"blockNumber,omitempty"`
+}
+
+type TaskResponse struct {
+	Tasks []Task `json:"tasks"`
+	Count int    `json:"count"`
+}
+
+type ContractAddressResponse struct {
+	RewardContract      string `json:"rewardContract"`
+	TaskContract        string `json:"taskContract"`
+	AchievementContract string `json:"achievementContract"`
+	Network             string `json:"network"`
+}
+
+// NFTAttribute mirrors an ERC-721 metadata attribute entry
+type NFTAttribute struct {
+	TraitType string      `json:"trait_type"`
+	Value     interface{} `json:"value"`
+}
+
+// NFTMetadata mirrors the JSON returned by a token's tokenURI
+type NFTMetadata struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Image       string         `json:"image"`
+	Attributes  []NFTAttribute `json:"attributes"`
+}
+
+type UserStatsResponse struct {
+	TasksCompleted  *big.Int `json:"tasksCompleted"`
+	TokensEarned    *big.Int `json:"tokensEarned"`
+	TasksCreated    *big.Int `json:"tasksCreated"`
+	CurrentStreak   *big.Int `json:"currentStreak"`
+	MaxStreak       *big.Int `json:"maxStreak"`
+	TokenBalance    *big.Int `json:"tokenBalance"`
+	AchievementNFTs []string `json:"achievementNFTs"`
+}
+
+// Utility functions
+func writeJSON(w http.ResponseWriter, data interface{}, statusCode int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+	if statusCode != 0 {
+		w.WriteHeader(statusCode)
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("Error encoding JSON response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, message string, statusCode int) {
+	writeJSON(w, APIResponse{
+		Success: false,
+		Error:   message,
+	}, statusCode)
+}
+
+func writeSuccess(w http.ResponseWriter, data interface{}, message string) {
+	writeJSON(w, APIResponse{
+		Success: true,
+		Data:    data,
+		Message: message,
+	}, http.StatusOK)
+}
+
+// initializeApp connects to cfg.RPCURL and loads cfg.DeploymentPath.
+func initializeApp(cfg config.Config) error {
+	log.Println("🚀 Initializing SideQuests Backend...")
+
+	var err error
+	ethClient, err = ethclient.Dial(cfg.RPCURL)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Ethereum network: %v", err)
+	}
+
+	deploymentBytes, err := os.ReadFile(cfg.DeploymentPath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %v", cfg.DeploymentPath, err)
+	}
+
+	if err := json.Unmarshal(deploymentBytes, &deploymentData); err != nil {
+		return fmt.Errorf("failed to parse %s: %v", cfg.DeploymentPath, err)
+	}
+
+	log.Printf("✅ Connected to %s network", deploymentData.Network)
+	log.Printf("📝 Task Contract: %s", deploymentData.TaskContract.Address)
+	log.Printf("💰 Reward Contract: %s", deploymentData.RewardContract.Address)
+	log.Printf("🏆 Achievement Contract: %s", deploymentData.AchievementBadgeContract.Address)
+
+	return nil
+}
+
+// CheckHealth connects to cfg.RPCURL and loads cfg.DeploymentPath without
+// starting a server, for the `antigel health` subcommand.
+func CheckHealth(cfg config.Config) (map[string]interface{}, error) {
+	if err := initializeApp(cfg); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	header, err := ethClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve block header: %v", err)
+	}
+
+	return map[string]interface{}{
+		"blockNumber": header.Number.String(),
+		"network":     deploymentData.Network,
+		"timestamp":   time.Now().Unix(),
+	}, nil
+}
+
+// ContractAddresses loads cfg.DeploymentPath and returns the deployed
+// contract addresses, for the `antigel contracts addresses` subcommand.
+func ContractAddresses(cfg config.Config) (ContractAddressResponse, error) {
+	if err := initializeApp(cfg); err != nil {
+		return ContractAddressResponse{}, err
+	}
+
+	return ContractAddressResponse{
+		RewardContract:      deploymentData.RewardContract.Address,
+		TaskContract:        deploymentData.TaskContract.Address,
+		AchievementContract: deploymentData.AchievementBadgeContract.Address,
+		Network:             deploymentData.Network,
+	}, nil
+}
+
+// API Handlers
+
+// Health check and network status
+func healthHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	header, err := ethClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		log.Printf("Failed to get block header: %v", err)
+		writeError(w, "Failed to retrieve block header from Ethereum", http.StatusInternalServerError)
+		return
+	}
+
+	blockNumber := header.Number
+	if blockNumber == nil {
+		blockNumber = big.NewInt(0)
+	}
+
+	writeSuccess(w, map[string]interface{}{
+		"blockNumber": blockNumber.String(),
+		"network":     deploymentData.Network,
+		"timestamp":   time.Now().Unix(),
+	}, "Network connection successful")
+}
+
+// Get contract addresses
+func contractAddressesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	response := ContractAddressResponse{
+		RewardContract:      deploymentData.RewardContract.Address,
+		TaskContract:        deploymentData.TaskContract.Address,
+		AchievementContract: deploymentData.AchievementBadgeContract.Address,
+		Network:             deploymentData.Network,
+	}
+
+	writeSuccess(w, response, "Contract addresses retrieved")
+}
+
+// Get contract ABIs
+func contractABIsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	response := map[string]interface{}{
+		"rewardContract":      json.RawMessage(deploymentData.RewardContract.ABI),
+		"taskContract":        json.RawMessage(deploymentData.TaskContract.ABI),
+		"achievementContract": json.RawMessage(deploymentData.AchievementBadgeContract.ABI),
+	}
+
+	writeSuccess(w, response, "Contract ABIs retrieved")
+}
+
+// fetchTasks reads every task. It backs both the /api/tasks REST route and
+// the sidequests_getTasks RPC method. When the event indexer has seen at
+// least one TaskCreated event it serves from the indexed store instead of
+// making a `tasks(i)` contract call per task.
+func fetchTasks(ctx context.Context) (TaskResponse, error) {
+	if taskIndexer != nil {
+		if states, err := taskIndexer.DeriveTasks(); err == nil {
+			tasks := make([]Task, 0, len(states))
+			for _, s := range states {
+				reward, _ := new(big.Int).SetString(s.Reward, 10)
+				tasks = append(tasks, Task{
+					ID:          new(big.Int).SetUint64(s.ID),
+					Title:       s.Title,
+					Description: s.Description,
+					Reward:      reward,
+					Creator:     s.Creator,
+					Worker:      s.Worker,
+					Completed:   s.Completed,
+				})
+			}
+			return TaskResponse{Tasks: tasks, Count: len(tasks)}, nil
+		}
+	}
+
+	taskABI, err := abi.JSON(strings.NewReader(string(deploymentData.TaskContract.ABI)))
+	if err != nil {
+		return TaskResponse{}, fmt.Errorf("failed to parse task contract ABI: %v", err)
+	}
+
+	// Typed binding: a reordered Solidity Task struct does not fail to
+	// compile here; see the contracts package doc for what it does catch.
+	taskContractAddress := common.HexToAddress(deploymentData.TaskContract.Address)
+	taskContract := contracts.NewTaskContract(taskContractAddress, taskABI, ethClient)
+
+	callOpts := &bind.CallOpts{Context: ctx}
+
+	taskCount, err := taskContract.GetTaskCount(callOpts)
+	if err != nil {
+		return TaskResponse{}, fmt.Errorf("failed to get task count: %v", err)
+	}
+
+	tasks := make([]Task, 0)
+
+	// Fetch each task (starting from 0 since arrays are 0-indexed)
+	for i := int64(0); i < taskCount.Int64(); i++ {
+		onChainTask, err := taskContract.Tasks(callOpts, big.NewInt(i))
+		if err != nil {
+			log.Printf("Error fetching task %d: %v", i, err)
+			continue
+		}
+
+		tasks = append(tasks, Task{
+			ID:          big.NewInt(i),
+			Title:       onChainTask.Title,
+			Description: onChainTask.Description,
+			Reward:      onChainTask.Reward,
+			Creator:     onChainTask.Creator.Hex(),
+			Worker:      onChainTask.Worker.Hex(),
+			Completed:   onChainTask.IsCompleted,
+		})
+	}
+
+	return TaskResponse{Tasks: tasks, Count: len(tasks)}, nil
+}
+
+// Get tasks from the blockchain
+func getTasksHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	response, err := fetchTasks(r.Context())
+	if err != nil {
+		writeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// fetchUserStats returns a user's stats. It backs both the
+// /api/users/stats REST route and the sidequests_getUserStats RPC method.
+// TasksCompleted/TasksCreated are derived from the indexed store when it is
+// available; the remaining fields still need richer contract support and
+// stay mocked for now.
+func fetchUserStats(ctx context.Context, address common.Address) (UserStatsResponse, error) {
+	stats := UserStatsResponse{
+		TasksCompleted:  big.NewInt(5),
+		TokensEarned:    big.NewInt(1000),
+		TasksCreated:    big.NewInt(3),
+		CurrentStreak:   big.NewInt(2),
+		MaxStreak:       big.NewInt(4),
+		TokenBalance:    big.NewInt(1000),
+		AchievementNFTs: []string{"1", "2"},
+	}
+
+	if taskIndexer == nil {
+		return stats, nil
+	}
+
+	states, err := taskIndexer.DeriveTasks()
+	if err != nil {
+		return stats, nil
+	}
+
+	addr := strings.ToLower(address.Hex())
+	var completed, created int64
+	for _, s := range states {
+		if strings.ToLower(s.Creator) == addr {
+			created++
+		}
+		if s.Completed && strings.ToLower(s.Worker) == addr {
+			completed++
+		}
+	}
+
+	stats.TasksCompleted = big.NewInt(completed)
+	stats.TasksCreated = big.NewInt(created)
+	return stats, nil
+}
+
+// Get user statistics (simplified version)
+func getUserStatsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	address, ok := addressFromRequest(r)
+	if !ok {
+		writeError(w, "authentication required", http.StatusUnauthorized)
+		return
+	}
+
+	response, err := fetchUserStats(r.Context(), address)
+	if err != nil {
+		writeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeSuccess(w, response, "User statistics retrieved")
+}
+
+const (
+	jsonDataURIPrefix       = "data:application/json,"
+	jsonBase64DataURIPrefix = "data:application/json;base64,"
+	svgBase64DataURIPrefix  = "data:image/svg+xml;base64,"
+)
+
+// decodeTokenURI decodes the JSON payload returned by tokenURI, which per
+// the ERC-721 metadata extension may be either URL-encoded
+// (data:application/json,...) or base64-encoded
+// (data:application/json;base64,...).
+func decodeTokenURI(tokenURI string) (*NFTMetadata, error) {
+	var decoded []byte
+
+	switch {
+	case strings.HasPrefix(tokenURI, jsonBase64DataURIPrefix):
+		raw, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(tokenURI, jsonBase64DataURIPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64 JSON: %v", err)
+		}
+		decoded = raw
+	case strings.HasPrefix(tokenURI, jsonDataURIPrefix):
+		unescaped, err := url.QueryUnescape(strings.TrimPrefix(tokenURI, jsonDataURIPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode URI: %v", err)
+		}
+		decoded = []byte(unescaped)
+	default:
+		return nil, fmt.Errorf("unsupported tokenURI format")
+	}
+
+	var metadata NFTMetadata
+	if err := json.Unmarshal(decoded, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse tokenURI JSON: %v", err)
+	}
+
+	return &metadata, nil
+}
+
+// decodeInlineSVG decodes an inline data:image/svg+xml;base64,... image URI
+// into raw SVG bytes. ok is false (with no error) for any other image URI,
+// such as a regular HTTP(S) link.
+func decodeInlineSVG(imageURI string) (svg []byte, ok bool, err error) {
+	if !strings.HasPrefix(imageURI, svgBase64DataURIPrefix) {
+		return nil, false, nil
+	}
+
+	svg, err = base64.StdEncoding.DecodeString(strings.TrimPrefix(imageURI, svgBase64DataURIPrefix))
+	if err != nil {
+		return nil, false, err
+	}
+	return svg, true, nil
+}
+
+// NFTMetadataResult is the fully-resolved result of reading a badge's
+// tokenURI: the raw URI, its decoded metadata, and - if the image was an
+// inline data URI - the decoded image bytes.
+type NFTMetadataResult struct {
+	TokenID          int64        `json:"tokenId"`
+	URI              string       `json:"uri"`
+	Metadata         *NFTMetadata `json:"metadata"`
+	Image            []byte       `json:"-"`
+	ImageContentType string       `json:"imageContentType,omitempty"`
+}
+
+// fetchNFTMetadata resolves a badge's tokenURI. It backs both the
+// /api/nft/metadata REST route and the sidequests_getNFTMetadata RPC method.
+func fetchNFTMetadata(ctx context.Context, tokenID int64) (*NFTMetadataResult, error) {
+	badgeABI, err := abi.JSON(strings.NewReader(string(deploymentData.AchievementBadgeContract.ABI)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse achievement contract ABI: %v", err)
+	}
+
+	badgeAddress := common.HexToAddress(deploymentData.AchievementBadgeContract.Address)
+	badgeContract := contracts.NewAchievementBadgeContract(badgeAddress, badgeABI, ethClient)
+
+	tokenURI, err := badgeContract.TokenURI(&bind.CallOpts{Context: ctx}, big.NewInt(tokenID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tokenURI: %v", err)
+	}
+
+	metadata, err := decodeTokenURI(tokenURI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode tokenURI: %v", err)
+	}
+
+	svg, isInlineSVG, err := decodeInlineSVG(metadata.Image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode inline SVG image: %v", err)
+	}
+
+	result := &NFTMetadataResult{TokenID: tokenID, URI: tokenURI, Metadata: metadata}
+	if isInlineSVG {
+		result.Image = svg
+		result.ImageContentType = "image/svg+xml"
+	}
+	return result, nil
+}
+
+// Get NFT metadata by calling the contract's tokenURI(uint256) method
+func getNFTMetadataHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	tokenIDStr := r.URL.Query().Get("tokenId")
+	if tokenIDStr == "" {
+		writeError(w, "Token ID is required", http.StatusBadRequest)
+		return
+	}
+
+	tokenID, err := strconv.ParseInt(tokenIDStr, 10, 64)
+	if err != nil {
+		writeError(w, "Invalid token ID", http.StatusBadRequest)
+		return
+	}
+
+	result, err := fetchNFTMetadata(r.Context(), tokenID)
+	if err != nil {
+		writeError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Clients that want the raw rendered image (e.g. an <img> tag) can ask
+	// for it directly instead of re-decoding the data URI themselves.
+	if result.Image != nil && r.URL.Query().Get("format") == "svg" {
+		w.Header().Set("Content-Type", result.ImageContentType)
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Write(result.Image)
+		return
+	}
+
+	writeSuccess(w, result, "NFT metadata retrieved")
+}
+
+// HTML Page Handlers
+func landingPageHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	// Add CORS headers
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	http.ServeFile(w, r, "landingpage.html")
+}
+
+func loginPageHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	// Add CORS headers
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	http.ServeFile(w, r, "login.html")
+}
+
+func registerPageHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	// Add CORS headers
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	http.ServeFile(w, r, "register.html")
+}
+
+func appPageHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	// Add CORS headers
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	http.ServeFile(w, r, "index.html")
+}
+
+// Static assets handler (for CSS, JS, images, etc.)
+func staticAssetsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	// Add CORS headers
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+	// Only allow specific file types for security
+	path := r.URL.Path[9:] // Remove "/static/" prefix
+	if isAllowedStaticFile(path) {
+		http.ServeFile(w, r, path)
+	} else {
+		http.Error(w, "File not found", http.StatusNotFound)
+	}
+}
+
+// Helper function to check allowed static file types
+func isAllowedStaticFile(path string) bool {
+	allowedExtensions := []string{".css", ".js", ".png", ".jpg", ".jpeg", ".gif", ".svg", ".ico", ".woff", ".woff2", ".ttf", ".eot"}
+	for _, ext := range allowedExtensions {
+		if strings.HasSuffix(strings.ToLower(path), ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// Serve initializes the Ethereum connection, JSON-RPC server, and event
+// indexer, then blocks serving HTTP on addr until it fails. jwtSecretValue
+// signs the SIWE session cookies issued by /api/auth/verify; an empty value
+// falls back to an insecure development secret.
+func Serve(cfg config.Config, addr string, jwtSecretValue string) error {
+	if err := initializeApp(cfg); err != nil {
+		return fmt.Errorf("failed to initialize application: %v", err)
+	}
+
+	if jwtSecretValue == "" {
+		log.Println("⚠️ ANTIGEL_JWT_SECRET is not set; signing sessions with an insecure development secret")
+		jwtSecretValue = "antigel-insecure-development-secret"
+	}
+	jwtSecret = []byte(jwtSecretValue)
+
+	rpcServer, err := newRPCServer()
+	if err != nil {
+		return fmt.Errorf("failed to initialize JSON-RPC server: %v", err)
+	}
+	defer rpcServer.Stop()
+
+	indexerCtx, stopIndexer := context.WithCancel(context.Background())
+	defer stopIndexer()
+	if err := startIndexer(indexerCtx); err != nil {
+		log.Printf("⚠️ Event indexer disabled: %v", err)
+	}
+
+	// JSON-RPC 2.0 surface: sidequests_/net_/web3_/events_ over both HTTP
+	// and WebSocket, with the same permissive CORS origin policy as the
+	// REST routes below. The "*" passed to WebsocketHandler is its allowed
+	// origins list, so any frontend origin can open /ws; no virtual-host
+	// filtering is applied on either route.
+	http.Handle("/rpc", corsHandler(rpcServer))
+	http.Handle("/ws", rpcServer.WebsocketHandler([]string{"*"}))
+
+	// The tasks, users/stats and nft/metadata REST routes below share their
+	// fetch* functions with the matching sidequests_ RPC methods above; the
+	// health, contracts and events routes have no RPC counterpart. They are
+	// kept during a deprecation window for existing clients that haven't
+	// migrated to JSON-RPC yet.
+	http.HandleFunc("/api/health", healthHandler)
+	http.HandleFunc("/api/contracts/addresses", contractAddressesHandler)
+	http.HandleFunc("/api/contracts/abis", contractABIsHandler)
+	http.HandleFunc("/api/tasks", getTasksHandler)
+	http.HandleFunc("/api/users/stats", requireAuth(getUserStatsHandler))
+	http.HandleFunc("/api/nft/metadata", requireAuth(getNFTMetadataHandler))
+	http.HandleFunc("/api/events", getEventsHandler)
+
+	// SIWE (EIP-4361) authentication: nonce issuance is public, verify
+	// starts the session the handlers above require.
+	http.HandleFunc("/api/auth/nonce", authNonceHandler)
+	http.HandleFunc("/api/auth/verify", authVerifyHandler)
+
+	// Set up page routes (secure HTML serving)
+	http.HandleFunc("/", landingPageHandler)
+	http.HandleFunc("/login", loginPageHandler)
+	http.HandleFunc("/register", registerPageHandler)
+	http.HandleFunc("/app", appPageHandler)
+
+	// Static assets (CSS, JS, images, etc.)
+	http.HandleFunc("/static/", staticAssetsHandler)
+
+	log.Printf("🚀 SideQuests Backend Server starting on http://localhost%s", addr)
+	log.Printf("🏠 Landing page available at: http://localhost%s/", addr)
+	log.Printf("🔐 Login page available at: http://localhost%s/login", addr)
+	log.Printf("📝 Register page available at: http://localhost%s/register", addr)
+	log.Printf("💻 Main app available at: http://localhost%s/app", addr)
+	log.Printf("🌐 JSON-RPC available at /rpc (HTTP) and /ws (WebSocket)")
+	log.Printf("🌐 REST API endpoints available at /api/* (deprecated in favor of JSON-RPC)")
+	log.Printf("🔑 SIWE sign-in available at /api/auth/nonce and /api/auth/verify")
+	log.Printf("🗂️ Static assets available at /static/*")
+
+	return http.ListenAndServe(addr, nil)
+}
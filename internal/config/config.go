@@ -0,0 +1,80 @@
+// Package config resolves the antigel binary's runtime configuration from
+// CLI flags with environment-variable overlays, so the same binary works
+// in dev, CI, and container deployments without code edits.
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Network is one of the chains antigel knows how to talk to.
+type Network string
+
+const (
+	NetworkMainnet Network = "mainnet"
+	NetworkSepolia Network = "sepolia"
+	NetworkLocal   Network = "local"
+)
+
+// defaultRPCURLs mirrors the hardcoded Sepolia Infura URL the codebase used
+// to bake in directly. Deliberately has no NetworkMainnet entry: that
+// Infura project ID isn't provisioned for mainnet, and silently falling
+// back to a testnet-only endpoint for real transactions is worse than
+// requiring an explicit --rpc-url/ANTIGEL_RPC_URL for it.
+var defaultRPCURLs = map[Network]string{
+	NetworkSepolia: "https://sepolia.infura.io/v3/713dcbe5e2254d718e5040c2ae716c3f",
+	NetworkLocal:   "http://localhost:8545",
+}
+
+// Config is the resolved configuration shared by every antigel subcommand.
+type Config struct {
+	RPCURL         string
+	DeploymentPath string
+	Network        Network
+	Verbosity      int
+}
+
+// Resolve builds a Config from CLI flag values, applying the
+// ANTIGEL_RPC_URL / ANTIGEL_DEPLOYMENT / ANTIGEL_NETWORK / ANTIGEL_VERBOSITY
+// environment variables as overlays when the corresponding flag was left at
+// its default (empty/zero) value. It returns an error when network is
+// mainnet and no rpcURL was supplied: unlike sepolia/local, there's no
+// default mainnet endpoint to fall back to.
+func Resolve(rpcURL, deploymentPath, network string, verbosity int) (Config, error) {
+	if v := os.Getenv("ANTIGEL_RPC_URL"); v != "" && rpcURL == "" {
+		rpcURL = v
+	}
+	if v := os.Getenv("ANTIGEL_DEPLOYMENT"); v != "" && deploymentPath == "" {
+		deploymentPath = v
+	}
+	if v := os.Getenv("ANTIGEL_NETWORK"); v != "" && network == "" {
+		network = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("ANTIGEL_VERBOSITY")); err == nil && verbosity == 0 {
+		verbosity = v
+	}
+
+	net := Network(network)
+	if net == "" {
+		net = NetworkSepolia
+	}
+
+	if rpcURL == "" {
+		rpcURL = defaultRPCURLs[net]
+	}
+	if rpcURL == "" {
+		return Config{}, fmt.Errorf("no RPC URL configured for network %q: pass --rpc-url or set ANTIGEL_RPC_URL", net)
+	}
+	if deploymentPath == "" {
+		deploymentPath = "python/deployment.json"
+	}
+
+	return Config{
+		RPCURL:         rpcURL,
+		DeploymentPath: deploymentPath,
+		Network:        net,
+		Verbosity:      verbosity,
+	}, nil
+}
@@ -0,0 +1,175 @@
+// Package jobqueue runs GraphRAG member selection as background asynq
+// jobs instead of inline in the HTTP request, so a slow GraphRAG query can
+// no longer tie up a request goroutine or time out the caller.
+package jobqueue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/Al3x-Myku/Antigel/internal/selector"
+	"github.com/Al3x-Myku/Antigel/internal/telemetry"
+)
+
+// TypeProcessTask is the asynq task type for a GraphRAG member-selection job.
+const TypeProcessTask = "task:process"
+
+// processTaskPayload is the JSON body of a TypeProcessTask job.
+type processTaskPayload struct {
+	Task    selector.TaskRequest `json:"task"`
+	TraceID string               `json:"trace_id"`
+}
+
+// Status is a job's lifecycle state.
+type Status string
+
+const (
+	StatusQueued     Status = "queued"
+	StatusProcessing Status = "processing"
+	StatusCompleted  Status = "completed"
+	StatusFailed     Status = "failed"
+)
+
+// JobState is what GET /tasks/{id}/status and /result report.
+type JobState struct {
+	TaskID    string                     `json:"task_id"`
+	JobID     string                     `json:"job_id"`
+	Status    Status                     `json:"status"`
+	Result    *selector.GraphRAGResponse `json:"result,omitempty"`
+	Error     string                     `json:"error,omitempty"`
+	UpdatedAt time.Time                  `json:"updated_at"`
+}
+
+// statusStore caches job state for fast polling without a Redis round trip
+// when the request happens to land back on the replica that ran the job.
+// It is only ever a cache: Client.Get falls back to asynq's own Redis-backed
+// task state (via Inspector) whenever a taskID isn't in this process's
+// cache, so polling still works correctly against any replica.
+type statusStore struct {
+	mu     sync.RWMutex
+	byTask map[string]*JobState
+}
+
+var states = &statusStore{byTask: make(map[string]*JobState)}
+
+func (s *statusStore) set(state *JobState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.byTask[state.TaskID] = state
+}
+
+func (s *statusStore) get(taskID string) (*JobState, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	state, ok := s.byTask[taskID]
+	return state, ok
+}
+
+// resultRetention is how long asynq keeps a completed job's result in Redis,
+// so a poll against a different replica than the one that ran the job can
+// still read it back.
+const resultRetention = 24 * time.Hour
+
+// Client enqueues GraphRAG selection jobs.
+type Client struct {
+	client    *asynq.Client
+	inspector *asynq.Inspector
+}
+
+// NewClient connects to the Redis instance at redisAddr.
+func NewClient(redisAddr string) *Client {
+	return &Client{
+		client:    asynq.NewClient(asynq.RedisClientOpt{Addr: redisAddr}),
+		inspector: asynq.NewInspector(asynq.RedisClientOpt{Addr: redisAddr}),
+	}
+}
+
+// Close releases the underlying Redis connections.
+func (c *Client) Close() error {
+	c.inspector.Close()
+	return c.client.Close()
+}
+
+// Get returns the current state of taskID's job, if one has been enqueued.
+// It checks this process's in-memory cache first, then falls back to
+// asynq's Redis-backed task state - necessary for GET /tasks/{id}/status to
+// work when the poll lands on a different replica than the one processing
+// (or that processed) the job, since statusStore is process-local.
+func (c *Client) Get(taskID string) (*JobState, bool) {
+	if state, ok := states.get(taskID); ok {
+		return state, true
+	}
+
+	info, err := c.inspector.GetTaskInfo(defaultQueue, taskID)
+	if err != nil {
+		return nil, false
+	}
+	return jobStateFromTaskInfo(taskID, info), true
+}
+
+// jobStateFromTaskInfo adapts asynq's view of a task to the JobState shape
+// GET /tasks/{id}/status and /result report, for a job this process never
+// ran itself.
+func jobStateFromTaskInfo(taskID string, info *asynq.TaskInfo) *JobState {
+	state := &JobState{TaskID: taskID, JobID: info.ID, UpdatedAt: info.LastFailedAt}
+
+	switch info.State {
+	case asynq.TaskStateCompleted:
+		state.Status = StatusCompleted
+		state.UpdatedAt = info.CompletedAt
+		var result selector.GraphRAGResponse
+		if err := json.Unmarshal(info.Result, &result); err == nil {
+			state.Result = &result
+		}
+	case asynq.TaskStateArchived:
+		state.Status = StatusFailed
+		state.Error = info.LastErr
+	case asynq.TaskStateActive:
+		state.Status = StatusProcessing
+	default:
+		state.Status = StatusQueued
+	}
+
+	return state
+}
+
+// Enqueue queues task for background processing and returns its job ID.
+// Jobs retry up to 5 times (asynq's default retry delay is already
+// exponential backoff with jitter) before being archived. The trace ID on
+// ctx (see telemetry.WithTraceID), if any, rides along in the job payload
+// so the worker and its Firestore write can be correlated back to the
+// request that enqueued them.
+//
+// The asynq task ID is set to task.ID (rather than left to asynq's random
+// default) so Client.Get can look a job up by task ID alone on any
+// replica, with no separate taskID->jobID mapping to keep in sync.
+func (c *Client) Enqueue(ctx context.Context, task selector.TaskRequest) (string, error) {
+	payload, err := json.Marshal(processTaskPayload{Task: task, TraceID: telemetry.TraceID(ctx)})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal job payload: %v", err)
+	}
+
+	info, err := c.client.EnqueueContext(ctx, asynq.NewTask(TypeProcessTask, payload),
+		asynq.TaskID(task.ID),
+		asynq.MaxRetry(5),
+		asynq.Timeout(5*time.Minute),
+		asynq.Retention(resultRetention),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to enqueue job: %v", err)
+	}
+
+	states.set(&JobState{
+		TaskID:    task.ID,
+		JobID:     info.ID,
+		Status:    StatusQueued,
+		UpdatedAt: time.Now(),
+	})
+
+	return info.ID, nil
+}
@@ -0,0 +1,60 @@
+package jobqueue
+
+import (
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/Al3x-Myku/Antigel/internal/telemetry"
+)
+
+const defaultQueue = "default"
+
+// NewServer builds an asynq worker server against the Redis instance at
+// redisAddr with the given worker concurrency (defaulting to 10). asynq's
+// default RetryDelayFunc already backs off exponentially with jitter, and
+// archives a task (its dead-letter queue) once MaxRetry is exhausted, so
+// neither needs reimplementing here. It also starts a background monitor
+// that keeps telemetry.WorkerQueueDepth in sync with the queue.
+func NewServer(redisAddr string, concurrency int) *asynq.Server {
+	if concurrency <= 0 {
+		concurrency = 10
+	}
+
+	go monitorQueueDepth(redisAddr, defaultQueue)
+
+	return asynq.NewServer(
+		asynq.RedisClientOpt{Addr: redisAddr},
+		asynq.Config{
+			Concurrency: concurrency,
+			Queues: map[string]int{
+				defaultQueue: 1,
+			},
+		},
+	)
+}
+
+// monitorQueueDepth polls queue's size from Redis and reports it as a
+// gauge, since asynq doesn't push queue depth to the handler directly.
+func monitorQueueDepth(redisAddr, queue string) {
+	inspector := asynq.NewInspector(asynq.RedisClientOpt{Addr: redisAddr})
+	defer inspector.Close()
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		info, err := inspector.GetQueueInfo(queue)
+		if err != nil {
+			continue
+		}
+		telemetry.WorkerQueueDepth.Set(float64(info.Size))
+	}
+}
+
+// NewMux registers handler against TypeProcessTask.
+func NewMux(handler *Handler) *asynq.ServeMux {
+	mux := asynq.NewServeMux()
+	mux.HandleFunc(TypeProcessTask, handler.ProcessTask)
+	return mux
+}
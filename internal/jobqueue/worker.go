@@ -0,0 +1,89 @@
+package jobqueue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"go.uber.org/zap"
+
+	"github.com/Al3x-Myku/Antigel/internal/selector"
+	"github.com/Al3x-Myku/Antigel/internal/telemetry"
+)
+
+// OnResultFunc persists a completed job's result (e.g. writing it back to
+// Firestore), the same way the handler used to once it got a result back
+// from the Selector synchronously. raw is the Selector's unparsed output,
+// if it produced one (see selector.WithRawCapture).
+type OnResultFunc func(ctx context.Context, task selector.TaskRequest, result *selector.GraphRAGResponse, raw []byte) error
+
+// Handler processes TypeProcessTask jobs by running Selector and handing
+// the result to OnResult.
+type Handler struct {
+	Selector selector.Selector
+	OnResult OnResultFunc
+}
+
+// ProcessTask implements asynq.Handler.
+func (h *Handler) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	var payload processTaskPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		// A malformed payload will never succeed on retry.
+		return fmt.Errorf("%w: invalid job payload: %v", asynq.SkipRetry, err)
+	}
+
+	ctx = telemetry.WithTraceID(ctx, payload.TraceID)
+	log := telemetry.Log.With(
+		zap.String("trace_id", payload.TraceID),
+		zap.String("task_id", payload.Task.ID),
+	)
+
+	jobID, _ := asynq.GetTaskID(ctx)
+	log = log.With(zap.String("job_id", jobID))
+	states.set(&JobState{TaskID: payload.Task.ID, JobID: jobID, Status: StatusProcessing, UpdatedAt: time.Now()})
+
+	telemetry.TasksInFlight.Inc()
+	defer telemetry.TasksInFlight.Dec()
+
+	ctx, raw := selector.WithRawCapture(ctx)
+	queryStart := time.Now()
+	result, err := h.Selector.SelectMembers(ctx, payload.Task)
+	telemetry.GraphRAGQueryDuration.Observe(time.Since(queryStart).Seconds())
+	if err != nil {
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		if retried >= maxRetry {
+			log.Warn("task exhausted retries, archiving to dead letter queue", zap.Int("max_retry", maxRetry))
+		}
+		states.set(&JobState{TaskID: payload.Task.ID, JobID: jobID, Status: StatusFailed, Error: err.Error(), UpdatedAt: time.Now()})
+		telemetry.TasksFailed.WithLabelValues("graphrag_selection").Inc()
+		log.Error("GraphRAG selection failed", zap.Error(err))
+		return fmt.Errorf("GraphRAG selection failed: %v", err)
+	}
+
+	if h.OnResult != nil {
+		if err := h.OnResult(ctx, payload.Task, result, raw.Bytes()); err != nil {
+			states.set(&JobState{TaskID: payload.Task.ID, JobID: jobID, Status: StatusFailed, Error: err.Error(), UpdatedAt: time.Now()})
+			telemetry.TasksFailed.WithLabelValues("persist").Inc()
+			log.Error("failed to persist GraphRAG result", zap.Error(err))
+			return fmt.Errorf("failed to persist GraphRAG result: %v", err)
+		}
+	}
+
+	states.set(&JobState{TaskID: payload.Task.ID, JobID: jobID, Status: StatusCompleted, Result: result, UpdatedAt: time.Now()})
+
+	// Also persist the result through asynq's own ResultWriter, so a poll
+	// against a replica other than this one can still read it back via
+	// Client.Get - statusStore above is only a same-process fast path.
+	if resultJSON, err := json.Marshal(result); err != nil {
+		log.Warn("failed to marshal result for asynq's result store", zap.Error(err))
+	} else if _, err := t.ResultWriter().Write(resultJSON); err != nil {
+		log.Warn("failed to write result to asynq's result store", zap.Error(err))
+	}
+
+	telemetry.TasksProcessed.Inc()
+	log.Info("task completed", zap.Int("selected_members", len(result.SelectedMembers)))
+	return nil
+}
@@ -0,0 +1,265 @@
+// Package nftviewer reads Achievement NFT badges off-chain via the
+// AchievementBadge contract's tokenURI method. The `antigel nft` family of
+// subcommands is a thin wrapper around Viewer.
+package nftviewer
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/Al3x-Myku/Antigel/internal/config"
+)
+
+// deploymentConfig is the slice of deployment.json a Viewer actually needs.
+type deploymentConfig struct {
+	AchievementBadgeContract struct {
+		Address string          `json:"address"`
+		ABI     json.RawMessage `json:"abi"`
+	} `json:"achievementBadgeContract"`
+}
+
+// NFTAttribute is a single ERC-721 metadata attribute entry.
+type NFTAttribute struct {
+	TraitType string      `json:"trait_type"`
+	Value     interface{} `json:"value"`
+}
+
+// NFTMetadata mirrors the JSON returned by a badge's tokenURI.
+type NFTMetadata struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Image       string         `json:"image"`
+	Attributes  []NFTAttribute `json:"attributes"`
+
+	// RenderedImage holds the decoded bytes when Image is an inline
+	// data:image/svg+xml;base64,... URI, so callers don't have to
+	// re-parse the data URI themselves.
+	RenderedImage     []byte `json:"-"`
+	RenderedImageType string `json:"-"`
+}
+
+// Viewer reads badge data from a single AchievementBadge contract.
+type Viewer struct {
+	client          *ethclient.Client
+	contractAddress common.Address
+	contractABI     abi.ABI
+}
+
+// Connect dials cfg.RPCURL and loads the AchievementBadge contract address
+// and ABI from cfg.DeploymentPath.
+func Connect(cfg config.Config) (*Viewer, error) {
+	client, err := ethclient.Dial(cfg.RPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %v", cfg.RPCURL, err)
+	}
+
+	deploymentBytes, err := os.ReadFile(cfg.DeploymentPath)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to read %s: %v", cfg.DeploymentPath, err)
+	}
+
+	var deployment deploymentConfig
+	if err := json.Unmarshal(deploymentBytes, &deployment); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to parse %s: %v", cfg.DeploymentPath, err)
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(string(deployment.AchievementBadgeContract.ABI)))
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to parse contract ABI: %v", err)
+	}
+
+	return &Viewer{
+		client:          client,
+		contractAddress: common.HexToAddress(deployment.AchievementBadgeContract.Address),
+		contractABI:     contractABI,
+	}, nil
+}
+
+// Close releases the underlying Ethereum client.
+func (v *Viewer) Close() error {
+	v.client.Close()
+	return nil
+}
+
+// callContract packs methodName+args, calls the contract, and unpacks the
+// result into result.
+func (v *Viewer) callContract(methodName string, result interface{}, args ...interface{}) error {
+	data, err := v.contractABI.Pack(methodName, args...)
+	if err != nil {
+		return fmt.Errorf("failed to pack method call: %v", err)
+	}
+
+	msg := ethereum.CallMsg{To: &v.contractAddress, Data: data}
+	output, err := v.client.CallContract(context.Background(), msg, nil)
+	if err != nil {
+		return fmt.Errorf("contract call failed: %v", err)
+	}
+
+	if err := v.contractABI.UnpackIntoInterface(result, methodName, output); err != nil {
+		return fmt.Errorf("failed to unpack result: %v", err)
+	}
+	return nil
+}
+
+// TotalSupply returns the number of badges minted so far.
+func (v *Viewer) TotalSupply() (int64, error) {
+	var result *big.Int
+	if err := v.callContract("totalSupply", &result); err != nil {
+		return 0, err
+	}
+	return result.Int64(), nil
+}
+
+// Metadata fetches and decodes the tokenURI for tokenID. The contract is
+// the source of truth: this calls tokenURI(uint256) rather than reading
+// badge struct fields directly.
+func (v *Viewer) Metadata(tokenID int64) (*NFTMetadata, error) {
+	var uri string
+	if err := v.callContract("tokenURI", &uri, big.NewInt(tokenID)); err != nil {
+		return nil, fmt.Errorf("failed to fetch tokenURI: %v", err)
+	}
+	return decodeDataURI(uri)
+}
+
+const (
+	jsonDataURIPrefix       = "data:application/json,"
+	jsonBase64DataURIPrefix = "data:application/json;base64,"
+	svgBase64DataURIPrefix  = "data:image/svg+xml;base64,"
+)
+
+// decodeDataURI decodes the metadata returned by tokenURI, which per the
+// ERC-721 metadata extension may be either URL-encoded
+// (data:application/json,...) or base64-encoded
+// (data:application/json;base64,...) JSON.
+func decodeDataURI(dataURI string) (*NFTMetadata, error) {
+	var decoded []byte
+
+	switch {
+	case strings.HasPrefix(dataURI, jsonBase64DataURIPrefix):
+		payload := strings.TrimPrefix(dataURI, jsonBase64DataURIPrefix)
+		raw, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64 JSON: %v", err)
+		}
+		decoded = raw
+	case strings.HasPrefix(dataURI, jsonDataURIPrefix):
+		payload := strings.TrimPrefix(dataURI, jsonDataURIPrefix)
+		unescaped, err := url.QueryUnescape(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode URI: %v", err)
+		}
+		decoded = []byte(unescaped)
+	default:
+		return nil, fmt.Errorf("invalid data URI format")
+	}
+
+	var metadata NFTMetadata
+	if err := json.Unmarshal(decoded, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %v", err)
+	}
+
+	if svg, ok, err := decodeSVGImage(metadata.Image); err != nil {
+		return nil, fmt.Errorf("failed to decode inline SVG image: %v", err)
+	} else if ok {
+		metadata.RenderedImage = svg
+		metadata.RenderedImageType = "image/svg+xml"
+	}
+
+	return &metadata, nil
+}
+
+// decodeSVGImage decodes an inline data:image/svg+xml;base64,... image URI
+// into raw SVG bytes. It returns ok=false (with no error) for any other
+// image URI, such as a regular HTTP(S) link.
+func decodeSVGImage(imageURI string) ([]byte, bool, error) {
+	if !strings.HasPrefix(imageURI, svgBase64DataURIPrefix) {
+		return nil, false, nil
+	}
+
+	payload := strings.TrimPrefix(imageURI, svgBase64DataURIPrefix)
+	svg, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, false, err
+	}
+	return svg, true, nil
+}
+
+// attributeValue looks up a trait_type in the metadata's attribute list.
+func attributeValue(metadata *NFTMetadata, traitType string) string {
+	for _, attr := range metadata.Attributes {
+		if attr.TraitType == traitType {
+			return fmt.Sprintf("%v", attr.Value)
+		}
+	}
+	return "Unknown"
+}
+
+// Summary is one badge's metadata flattened for `nft list`/`nft export`.
+type Summary struct {
+	TokenID         int64  `json:"tokenId"`
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	AchievementType string `json:"achievementType"`
+	Rarity          string `json:"rarity"`
+	Image           string `json:"image"`
+}
+
+// List fetches and summarizes every minted badge.
+func (v *Viewer) List() ([]Summary, error) {
+	totalSupply, err := v.TotalSupply()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get total supply: %v", err)
+	}
+
+	summaries := make([]Summary, 0, totalSupply)
+	for i := int64(1); i <= totalSupply; i++ {
+		metadata, err := v.Metadata(i)
+		if err != nil {
+			continue
+		}
+		summaries = append(summaries, Summary{
+			TokenID:         i,
+			Title:           metadata.Name,
+			Description:     metadata.Description,
+			AchievementType: attributeValue(metadata, "Achievement Type"),
+			Rarity:          attributeValue(metadata, "Rarity"),
+			Image:           metadata.Image,
+		})
+	}
+
+	return summaries, nil
+}
+
+// PrintBadge writes a human-readable badge summary to stdout, in the
+// original viewNFT CLI's format.
+func PrintBadge(tokenID int64, metadata *NFTMetadata) {
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Printf("🏆 NFT #%d: %s\n", tokenID, metadata.Name)
+	fmt.Println(strings.Repeat("=", 70))
+	fmt.Printf("\n📊 NFT INFORMATION:\n")
+	fmt.Printf("  Achievement Type: %s\n", attributeValue(metadata, "Achievement Type"))
+	fmt.Printf("  Title: %s\n", metadata.Name)
+	fmt.Printf("  Description: %s\n", metadata.Description)
+	fmt.Printf("  Rarity: %s\n", attributeValue(metadata, "Rarity"))
+	fmt.Printf("\n🖼️  IMAGE:\n")
+	if metadata.RenderedImage != nil {
+		fmt.Printf("  Inline %s image (%d bytes decoded from tokenURI)\n", metadata.RenderedImageType, len(metadata.RenderedImage))
+	} else {
+		fmt.Printf("  URL: %s\n", metadata.Image)
+	}
+	fmt.Println(strings.Repeat("=", 70))
+}
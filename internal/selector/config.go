@@ -0,0 +1,39 @@
+package selector
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// NewFromEnv builds the Selector implementation an operator chose via
+// GRAPHRAG_SELECTOR (subprocess, http, or grpc; defaults to subprocess,
+// the prior hard-coded behavior), so swapping engines is a deploy-time
+// config change instead of a code change.
+func NewFromEnv() (Selector, error) {
+	switch strings.ToLower(os.Getenv("GRAPHRAG_SELECTOR")) {
+	case "", "subprocess":
+		root := os.Getenv("GRAPHRAG_ROOT")
+		if root == "" {
+			root = "../python/graphrag"
+		}
+		return NewSubprocessSelector(root), nil
+
+	case "http":
+		url := os.Getenv("GRAPHRAG_HTTP_URL")
+		if url == "" {
+			return nil, fmt.Errorf("GRAPHRAG_HTTP_URL is required when GRAPHRAG_SELECTOR=http")
+		}
+		return NewHTTPSelector(url, nil), nil
+
+	case "grpc":
+		target := os.Getenv("GRAPHRAG_GRPC_TARGET")
+		if target == "" {
+			return nil, fmt.Errorf("GRAPHRAG_GRPC_TARGET is required when GRAPHRAG_SELECTOR=grpc")
+		}
+		return NewGRPCSelector(target)
+
+	default:
+		return nil, fmt.Errorf("unknown GRAPHRAG_SELECTOR %q (want subprocess, http, or grpc)", os.Getenv("GRAPHRAG_SELECTOR"))
+	}
+}
@@ -0,0 +1,73 @@
+package selector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+)
+
+// selectMembersMethod is the gRPC method GRPCSelector invokes. There is no
+// .proto schema yet, so requests/responses travel as the same JSON bodies
+// HTTPSelector uses (see jsonCodec below) rather than hand-written
+// protobuf messages; swapping in a real schema later is a codec change,
+// not a call-site change.
+const selectMembersMethod = "/graphrag.GraphRAGService/SelectMembers"
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// jsonCodec lets grpc-go carry plain Go structs over an HTTP/2 connection
+// via encoding/json instead of requiring generated protobuf types.
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string                          { return "json" }
+func (jsonCodec) Marshal(v interface{}) ([]byte, error)  { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// GRPCSelector calls a GraphRAG service over gRPC, for deployments that
+// need HTTP/2 multiplexing and streaming-friendly transport that the
+// plain HTTPSelector doesn't offer.
+type GRPCSelector struct {
+	conn *grpc.ClientConn
+}
+
+// NewGRPCSelector dials the GraphRAG gRPC service at target. Callers
+// needing TLS should pass their own grpc.WithTransportCredentials option;
+// with none given this dials insecure, matching a typical same-cluster
+// sidecar deployment.
+func NewGRPCSelector(target string, opts ...grpc.DialOption) (*GRPCSelector, error) {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	opts = append(opts, grpc.WithDefaultCallOptions(grpc.CallContentSubtype(jsonCodec{}.Name())))
+
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial GraphRAG gRPC service at %s: %v", target, err)
+	}
+	return &GRPCSelector{conn: conn}, nil
+}
+
+// Close releases the underlying connection.
+func (s *GRPCSelector) Close() error {
+	return s.conn.Close()
+}
+
+// SelectMembers invokes the GraphRAG service's SelectMembers method.
+func (s *GRPCSelector) SelectMembers(ctx context.Context, task TaskRequest) (*GraphRAGResponse, error) {
+	var response GraphRAGResponse
+	if err := s.conn.Invoke(ctx, selectMembersMethod, &task, &response); err != nil {
+		return nil, fmt.Errorf("GraphRAG gRPC call failed: %v", err)
+	}
+	if response.TaskID == "" {
+		response.TaskID = task.ID
+	}
+	return &response, nil
+}
@@ -0,0 +1,61 @@
+package selector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HTTPSelector posts a task to a long-running GraphRAG HTTP service and
+// decodes its well-typed JSON response, instead of shelling out to a fresh
+// process per task.
+type HTTPSelector struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewHTTPSelector builds an HTTPSelector that POSTs to url. A nil client
+// gets a 30-second-timeout default.
+func NewHTTPSelector(url string, client *http.Client) *HTTPSelector {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &HTTPSelector{URL: url, Client: client}
+}
+
+// SelectMembers POSTs task as JSON and decodes a GraphRAGResponse.
+func (s *HTTPSelector) SelectMembers(ctx context.Context, task TaskRequest) (*GraphRAGResponse, error) {
+	body, err := json.Marshal(task)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling task to JSON: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error building GraphRAG request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("GraphRAG request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GraphRAG service returned status %d", resp.StatusCode)
+	}
+
+	var response GraphRAGResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("error decoding GraphRAG response: %v", err)
+	}
+	if response.TaskID == "" {
+		response.TaskID = task.ID
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,36 @@
+package selector
+
+import "context"
+
+// rawCaptureKey namespaces the RawCapture stored on a context.
+type rawCaptureKey struct{}
+
+// RawCapture collects the unparsed bytes a Selector implementation saw on
+// the wire - SubprocessSelector's stdout, say - when it has any.
+// HTTPSelector and GRPCSelector already return structured JSON and leave
+// it unset.
+type RawCapture struct {
+	bytes []byte
+}
+
+// WithRawCapture returns a context a Selector can stash raw output into
+// via captureRaw, plus the RawCapture to read it back from once
+// SelectMembers returns.
+func WithRawCapture(ctx context.Context) (context.Context, *RawCapture) {
+	capture := &RawCapture{}
+	return context.WithValue(ctx, rawCaptureKey{}, capture), capture
+}
+
+// Bytes returns the captured raw output, or nil if the Selector didn't
+// produce one.
+func (c *RawCapture) Bytes() []byte {
+	return c.bytes
+}
+
+// captureRaw stashes data into ctx's RawCapture, if the caller asked for
+// one via WithRawCapture. It is a no-op otherwise.
+func captureRaw(ctx context.Context, data []byte) {
+	if capture, ok := ctx.Value(rawCaptureKey{}).(*RawCapture); ok {
+		capture.bytes = data
+	}
+}
@@ -0,0 +1,234 @@
+package selector
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	"go.uber.org/zap"
+
+	"github.com/Al3x-Myku/Antigel/internal/telemetry"
+)
+
+// SubprocessSelector runs a local `graphrag query` process per task, same
+// as the original hard-coded exec.Command call, but with context
+// cancellation and a strict wire protocol: the process must emit
+// newline-delimited JSON events on stdout instead of arbitrary log lines
+// the caller has to scrape for a `{...}` substring.
+type SubprocessSelector struct {
+	// Root is the GraphRAG project root passed via --root.
+	Root string
+	// Method is the GraphRAG query method passed via --method.
+	Method string
+}
+
+// NewSubprocessSelector builds a SubprocessSelector rooted at root, using
+// GraphRAG's "local" query method.
+func NewSubprocessSelector(root string) *SubprocessSelector {
+	return &SubprocessSelector{Root: root, Method: "local"}
+}
+
+// SelectMembers runs `graphrag query` and streams its stdout as NDJSON
+// events (see decodeNDJSON), rather than buffering the whole process
+// output and scanning it for a JSON-looking line afterwards.
+func (s *SubprocessSelector) SelectMembers(ctx context.Context, task TaskRequest) (*GraphRAGResponse, error) {
+	log := telemetry.Log.With(zap.String("trace_id", telemetry.TraceID(ctx)), zap.String("task_id", task.ID))
+	log.Info("running GraphRAG query")
+
+	taskJSON, err := json.Marshal(task)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling task to JSON: %v", err)
+	}
+	query := fmt.Sprintf("Find the best team members for this task: %s", string(taskJSON))
+
+	cmd := exec.CommandContext(ctx, "graphrag", "query",
+		"--root", s.Root,
+		"--method", s.Method,
+		"--query", query)
+
+	stdoutPipe, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GraphRAG stdout: %v", err)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start GraphRAG command: %v", err)
+	}
+
+	// Tee stdout into a buffer so the full, unparsed stream can still be
+	// saved as an artifact even though it's consumed as NDJSON here.
+	var raw bytes.Buffer
+	response, parseErr := decodeNDJSON(io.TeeReader(stdoutPipe, &raw), task.ID)
+
+	if err := cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("GraphRAG command failed: %v, stderr: %s", err, stderr.String())
+	}
+
+	captureRaw(ctx, raw.Bytes())
+
+	if parseErr != nil {
+		return nil, fmt.Errorf("error parsing GraphRAG output: %v", parseErr)
+	}
+
+	log.Info("GraphRAG query completed", zap.Int("selected_members", len(response.SelectedMembers)))
+	return response, nil
+}
+
+// NDJSON event schemas. The GraphRAG process must emit one of these per
+// line; anything else is a protocol violation, not something to silently
+// ignore or paper over with default scores.
+const (
+	memberEventSchema = `{
+		"type": "object",
+		"required": ["type", "user_id", "name", "score"],
+		"properties": {
+			"type": {"enum": ["member"]},
+			"user_id": {"type": "string"},
+			"name": {"type": "string"},
+			"skills": {"type": "array", "items": {"type": "string"}},
+			"score": {"type": "number"},
+			"rationale": {"type": "string"}
+		}
+	}`
+	reasoningEventSchema = `{
+		"type": "object",
+		"required": ["type", "reasoning"],
+		"properties": {
+			"type": {"enum": ["reasoning"]},
+			"reasoning": {"type": "string"}
+		}
+	}`
+	doneEventSchema = `{
+		"type": "object",
+		"required": ["type", "confidence"],
+		"properties": {
+			"type": {"enum": ["done"]},
+			"confidence": {"type": "number"}
+		}
+	}`
+)
+
+var (
+	memberSchema    = mustCompileSchema(memberEventSchema)
+	reasoningSchema = mustCompileSchema(reasoningEventSchema)
+	doneSchema      = mustCompileSchema(doneEventSchema)
+)
+
+func mustCompileSchema(raw string) *gojsonschema.Schema {
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(raw))
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded NDJSON event schema: %v", err))
+	}
+	return schema
+}
+
+type memberEvent struct {
+	UserID    string   `json:"user_id"`
+	Name      string   `json:"name"`
+	Skills    []string `json:"skills"`
+	Score     float64  `json:"score"`
+	Rationale string   `json:"rationale"`
+}
+
+type reasoningEvent struct {
+	Reasoning string `json:"reasoning"`
+}
+
+type doneEvent struct {
+	Confidence float64 `json:"confidence"`
+}
+
+// decodeNDJSON reads newline-delimited JSON events from r, validating each
+// against its type's schema, and folds them into a GraphRAGResponse. It
+// returns an error - rather than an empty, quietly-wrong response - on the
+// first malformed line, unrecognized event type, or schema violation, and
+// if the stream ends without a "done" event.
+func decodeNDJSON(r io.Reader, taskID string) (*GraphRAGResponse, error) {
+	response := &GraphRAGResponse{TaskID: taskID}
+	sawDone := false
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var envelope struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal([]byte(line), &envelope); err != nil {
+			return nil, fmt.Errorf("malformed NDJSON event %q: %v", line, err)
+		}
+
+		loader := gojsonschema.NewStringLoader(line)
+
+		switch envelope.Type {
+		case "member":
+			if err := validateEvent(memberSchema, loader); err != nil {
+				return nil, fmt.Errorf("invalid member event: %v", err)
+			}
+			var event memberEvent
+			json.Unmarshal([]byte(line), &event)
+			response.SelectedMembers = append(response.SelectedMembers, SelectedMember{
+				UserID:    event.UserID,
+				Name:      event.Name,
+				Skills:    event.Skills,
+				Score:     event.Score,
+				Rationale: event.Rationale,
+			})
+		case "reasoning":
+			if err := validateEvent(reasoningSchema, loader); err != nil {
+				return nil, fmt.Errorf("invalid reasoning event: %v", err)
+			}
+			var event reasoningEvent
+			json.Unmarshal([]byte(line), &event)
+			response.Reasoning = event.Reasoning
+		case "done":
+			if err := validateEvent(doneSchema, loader); err != nil {
+				return nil, fmt.Errorf("invalid done event: %v", err)
+			}
+			var event doneEvent
+			json.Unmarshal([]byte(line), &event)
+			response.Confidence = event.Confidence
+			sawDone = true
+		default:
+			return nil, fmt.Errorf("unknown NDJSON event type %q", envelope.Type)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read NDJSON stream: %v", err)
+	}
+	if !sawDone {
+		return nil, fmt.Errorf("GraphRAG process ended without a done event")
+	}
+
+	return response, nil
+}
+
+// validateEvent checks loader against schema, collecting every validation
+// error into a single message.
+func validateEvent(schema *gojsonschema.Schema, loader gojsonschema.JSONLoader) error {
+	result, err := schema.Validate(loader)
+	if err != nil {
+		return err
+	}
+	if !result.Valid() {
+		messages := make([]string, len(result.Errors()))
+		for i, e := range result.Errors() {
+			messages[i] = e.String()
+		}
+		return fmt.Errorf("%s", strings.Join(messages, "; "))
+	}
+	return nil
+}
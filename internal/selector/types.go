@@ -0,0 +1,41 @@
+// Package selector decouples task -> team-member matching from how that
+// matching is actually performed. A Selector can shell out to a local
+// GraphRAG process, call a long-running HTTP service, or call a gRPC
+// service; callers only ever see the Selector interface.
+package selector
+
+import "context"
+
+// TaskRequest is the task a Selector is asked to find members for.
+type TaskRequest struct {
+	ID           string   `json:"id"`
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	Requirements []string `json:"requirements"`
+	Skills       []string `json:"skills"`
+	Reward       float64  `json:"reward"`
+	Deadline     string   `json:"deadline"`
+	Creator      string   `json:"creator"`
+}
+
+// GraphRAGResponse is a Selector's answer for a TaskRequest.
+type GraphRAGResponse struct {
+	TaskID          string           `json:"task_id"`
+	SelectedMembers []SelectedMember `json:"selected_members"`
+	Confidence      float64          `json:"confidence"`
+	Reasoning       string           `json:"reasoning"`
+}
+
+// SelectedMember is one member a Selector chose for a task.
+type SelectedMember struct {
+	UserID    string   `json:"user_id"`
+	Name      string   `json:"name"`
+	Skills    []string `json:"skills"`
+	Score     float64  `json:"score"`
+	Rationale string   `json:"rationale"`
+}
+
+// Selector matches a task to candidate team members.
+type Selector interface {
+	SelectMembers(ctx context.Context, task TaskRequest) (*GraphRAGResponse, error)
+}
@@ -0,0 +1,25 @@
+// Package telemetry is the structured logging, metrics, and request
+// tracing shared by the GraphRAG/Firestore task pipeline (endpointHTTP,
+// internal/jobqueue, internal/selector, internal/autotrigger), so the
+// pipeline can be observed once it's deployed and running many concurrent
+// GraphRAG queries instead of relying on scattered log.Printf calls.
+package telemetry
+
+import "go.uber.org/zap"
+
+// Log is the pipeline's structured logger. It defaults to a no-op logger
+// so packages can log against it before Init runs (e.g. in tests or a
+// dry-run), and is replaced once Init succeeds.
+var Log = zap.NewNop()
+
+// Init configures Log for production use. Call it once at startup; on
+// error the caller should fail fast the same way it would on any other
+// init step (Firebase, Redis, ...).
+func Init() error {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return err
+	}
+	Log = logger
+	return nil
+}
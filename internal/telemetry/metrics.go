@@ -0,0 +1,63 @@
+package telemetry
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	// GraphRAGQueryDuration times a Selector's SelectMembers call.
+	GraphRAGQueryDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "graphrag_query_duration_seconds",
+		Help:    "Time spent waiting for a GraphRAG Selector to return a result.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	// FirestoreUpdateDuration times writing a task's result back to Firestore.
+	FirestoreUpdateDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "firestore_update_duration_seconds",
+		Help:    "Time spent updating a task document in Firestore.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	// HTTPRequestDuration times an HTTP request by route and response status.
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Time spent handling an HTTP request.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "status"})
+
+	// TasksProcessed counts tasks whose GraphRAG result was persisted successfully.
+	TasksProcessed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tasks_processed_total",
+		Help: "Tasks that completed GraphRAG selection and were persisted successfully.",
+	})
+
+	// TasksFailed counts failed tasks by the stage that failed them.
+	TasksFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tasks_failed_total",
+		Help: "Tasks that failed, labeled by failure reason.",
+	}, []string{"reason"})
+
+	// TasksInFlight is the number of tasks a worker is actively processing right now.
+	TasksInFlight = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "tasks_in_flight",
+		Help: "Tasks currently being processed by a worker.",
+	})
+
+	// WorkerQueueDepth is the number of tasks sitting in the asynq queue
+	// (pending, active, scheduled, retrying, aggregating, or archived),
+	// polled from Redis.
+	WorkerQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "worker_queue_depth",
+		Help: "Tasks currently sitting in the worker queue.",
+	})
+)
+
+// Handler serves the pipeline's Prometheus metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,34 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+)
+
+// traceIDKey namespaces the trace ID stored on a context.
+type traceIDKey struct{}
+
+// NewTraceID generates a per-request/per-job identifier that can be
+// threaded through the GraphRAG/Firestore pipeline and logged at every
+// stage, so a single request can be followed across the HTTP handler, the
+// worker, and the resulting Firestore write.
+func NewTraceID() (string, error) {
+	raw := make([]byte, 8)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate trace id: %v", err)
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+// WithTraceID attaches id to ctx.
+func WithTraceID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, traceIDKey{}, id)
+}
+
+// TraceID returns the trace ID attached to ctx, or "" if none was set.
+func TraceID(ctx context.Context) string {
+	id, _ := ctx.Value(traceIDKey{}).(string)
+	return id
+}